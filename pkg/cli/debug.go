@@ -1663,6 +1663,7 @@ var debugCmds = []*cobra.Command{
 	debugEnvCmd,
 	debugZipCmd,
 	debugMergeLogsCmd,
+	debugAuditReportCmd,
 	debugListFilesCmd,
 	debugResetQuorumCmd,
 	debugSendKVBatchCmd,
@@ -1814,6 +1815,16 @@ func init() {
 	f.Var(&debugMergeLogsOpts.useColor, "color",
 		"force use of TTY escape codes to colorize the output")
 
+	f = debugAuditReportCmd.Flags()
+	f.StringVar(&debugAuditReportOpts.format, "format", debugAuditReportOpts.format,
+		"output format: json or csv")
+	f.StringVar(&debugAuditReportOpts.section, "section", debugAuditReportOpts.section,
+		"report to print in CSV format: ddl, users or auth")
+	f.BoolVar(&debugAuditReportOpts.redactInput, "redact", debugAuditReportOpts.redactInput,
+		"redact the input files to remove sensitive information")
+	f.Uint32Var(&debugAuditReportOpts.descriptorID, "descriptor-id", debugAuditReportOpts.descriptorID,
+		"only report the events affecting the descriptor with this ID")
+
 	f = debugDecodeKeyCmd.Flags()
 	f.Var(&decodeKeyOptions.encoding, "encoding", "key argument encoding")
 
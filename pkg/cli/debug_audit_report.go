@@ -0,0 +1,76 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/log/auditreport"
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/cobra"
+)
+
+var debugAuditReportCmd = &cobra.Command{
+	Use:   "audit-report <log directory> [<log directory>...]",
+	Short: "produce audit reports from the structured events in log files",
+	Long: `
+Reads the structured events from the log files in the given directories
+and produces aggregated audit reports: a timeline of DDL operations, a
+summary of the activity of each user, and the authentication failures
+grouped by client IP address.
+
+With --format=json (the default), all the reports are printed as a single
+JSON object. With --format=csv, only the report selected with --section
+is printed.
+
+With --descriptor-id, the reports only include the events affecting the
+descriptor with the given ID, such as a table.
+`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runDebugAuditReport,
+}
+
+var debugAuditReportOpts = struct {
+	format       string
+	section      string
+	redactInput  bool
+	descriptorID uint32
+}{
+	format:  "json",
+	section: string(auditreport.SectionDDL),
+}
+
+func runDebugAuditReport(cmd *cobra.Command, args []string) error {
+	o := debugAuditReportOpts
+	editMode := log.SelectEditMode(o.redactInput, false /* keepRedactable */)
+
+	b := auditreport.NewBuilder()
+	for _, dir := range args {
+		if err := b.ReadDir(dir, editMode); err != nil {
+			return err
+		}
+	}
+	if o.descriptorID != 0 {
+		var err error
+		if b, err = b.ForDescriptor(o.descriptorID); err != nil {
+			return err
+		}
+	}
+	r := b.Report()
+
+	switch o.format {
+	case "json":
+		return r.WriteJSON(cmd.OutOrStdout())
+	case "csv":
+		return r.WriteCSV(cmd.OutOrStdout(), auditreport.Section(o.section))
+	default:
+		return errors.Newf("unknown output format: %q", o.format)
+	}
+}
@@ -120,6 +120,7 @@ func RegisterTests(r registry.Registry) {
 	registerTPCHConcurrency(r)
 	registerTPCHVec(r)
 	registerUnoptimizedQueryOracle(r)
+	registerVectorizeOracle(r)
 	registerKVBench(r)
 	registerTypeORM(r)
 	registerLoadSplits(r)
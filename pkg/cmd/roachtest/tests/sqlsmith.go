@@ -336,7 +336,9 @@ INSERT INTO seed_mr_table DEFAULT VALUES;`, regionList[0]),
 	}
 	setups["seed-multi-region"] = sqlsmith.Setups["seed-multi-region"]
 	settings["ddl-nodrop"] = sqlsmith.Settings["ddl-nodrop"]
+	settings["ddl-heavy"] = sqlsmith.Settings["ddl-heavy"]
 	settings["multi-region"] = sqlsmith.Settings["multi-region"]
 	register("tpcc", "ddl-nodrop")
+	register("tpcc", "ddl-heavy")
 	register("seed-multi-region", "multi-region")
 }
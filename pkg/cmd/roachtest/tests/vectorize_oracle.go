@@ -0,0 +1,145 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package tests
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/cmd/roachtest/cluster"
+	"github.com/cockroachdb/cockroach/pkg/cmd/roachtest/registry"
+	"github.com/cockroachdb/cockroach/pkg/cmd/roachtest/test"
+	"github.com/cockroachdb/cockroach/pkg/internal/sqlsmith"
+	"github.com/cockroachdb/errors"
+)
+
+// registerVectorizeOracle registers a test that compares the results of the
+// vectorized and row-based execution engines on random queries. smithcmp can
+// do a similar comparison with pkg/cmd/smithcmp/vec.toml, but it has to be
+// run manually, against a fixed table, and the tpchvec/smithcmp test runs a
+// pinned, prebuilt smithcmp binary on the TPC-H schema. This test instead
+// runs nightly with the current sqlsmith, on the random setups of the query
+// comparison tests, and reports failures with the statements needed to
+// reproduce them.
+func registerVectorizeOracle(r registry.Registry) {
+	r.Add(registry.TestSpec{
+		Name:            "vectorize-oracle",
+		Owner:           registry.OwnerSQLQueries,
+		Timeout:         time.Hour * 1,
+		RequiresLicense: true,
+		Tags:            nil,
+		Cluster:         r.MakeClusterSpec(1),
+		Run: func(ctx context.Context, t test.Test, c cluster.Cluster) {
+			runQueryComparison(ctx, t, c, &queryComparisonTest{
+				name: "vectorize-oracle",
+				run:  runVectorizeOracleQuery,
+			})
+		},
+	})
+}
+
+// vectorizedOnlyErrors are the errors that can legitimately happen only with
+// the vectorized engine enabled. The vectorized engine evaluates projections
+// on whole batches of rows, so it can evaluate an expression on rows that the
+// row-based engine never evaluates it on, for example because a CASE or a
+// filter would have excluded them. Evaluating it on such rows can cause:
+//   - division by zero, as in CASE WHEN b != 0 THEN a / b END;
+//   - overflows, as in CASE WHEN a < 100 THEN a * 1e18::INT8 END.
+//
+// Statement timeouts are allowed too, since the two executions don't take
+// exactly the same time.
+var vectorizedOnlyErrors = []string{
+	"division by zero",
+	"out of range",
+	"statement timeout",
+}
+
+// runVectorizeOracleQuery executes the same query two times, once with the
+// vectorized execution engine disabled and once with it enabled. Since the
+// optimizer settings are the same, both executions use the same plan, so any
+// difference is caused by the execution engines. An error is returned if the
+// results of the two executions are not equal, if either execution fails
+// with an internal error, or if only the execution with the vectorized engine
+// enabled fails with an error other than the vectorizedOnlyErrors. Statements
+// that fail with the vectorized engine disabled are skipped.
+func runVectorizeOracleQuery(
+	smither *sqlsmith.Smither, rnd *rand.Rand, h queryComparisonHelper,
+) error {
+	var stmt string
+	// Ignore panics from Generate.
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				return
+			}
+		}()
+		stmt = smither.Generate()
+	}()
+
+	var verboseLogging bool
+	defer func() {
+		// As in the unoptimized query oracle, all statements are logged, since
+		// the state left behind by the previous iterations might be needed to
+		// reproduce a failure.
+		h.logStatements()
+		if verboseLogging {
+			h.logVerboseOutput()
+		}
+	}()
+
+	// First, run the statement with the row-based engine.
+	if err := h.execStmt("SET vectorize = off"); err != nil {
+		return h.makeError(err, "failed to disable the vectorized engine")
+	}
+	rowRows, err := h.runQuery(stmt)
+	if err != nil {
+		if strings.Contains(err.Error(), "internal error") {
+			verboseLogging = true
+			return h.makeError(err, "internal error while running statement with the vectorized engine disabled")
+		}
+		// Skip statements that fail with a non-internal error.
+		//nolint:returnerrcheck
+		return nil
+	}
+
+	// Then, rerun the statement with the vectorized engine.
+	if err := h.execStmt("RESET vectorize"); err != nil {
+		return h.makeError(err, "failed to reset the vectorized engine")
+	}
+	vecRows, err := h.runQuery(stmt)
+	if err != nil {
+		es := err.Error()
+		if strings.Contains(es, "internal error") {
+			verboseLogging = true
+			return h.makeError(err, "internal error while running statement with the vectorized engine enabled")
+		}
+		for _, allowed := range vectorizedOnlyErrors {
+			if strings.Contains(es, allowed) {
+				//nolint:returnerrcheck
+				return nil
+			}
+		}
+		verboseLogging = true
+		return h.makeError(err, "statement failed only with the vectorized engine enabled")
+	}
+
+	if diff := unsortedMatricesDiff(rowRows, vecRows); diff != "" {
+		// We have a mismatch in the row-based vs vectorized query outputs.
+		verboseLogging = true
+		return h.makeError(errors.Newf(
+			"expected row-based and vectorized results to be equal\n%s\nsql: %s\n",
+			diff, stmt,
+		), "")
+	}
+	return nil
+}
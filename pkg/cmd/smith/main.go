@@ -12,6 +12,7 @@ package main
 
 import (
 	gosql "database/sql"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
@@ -47,10 +48,14 @@ var (
 	expr          = flags.Bool("expr", false, "generate expressions instead of statements")
 	num           = flags.Int("num", 1, "number of statements / expressions to generate")
 	url           = flags.String("url", "", "database to fetch schema from")
+	weights       = flags.String("weights", "", "JSON file with production weights (see sqlsmith.Weights)")
+	coverage      = flags.Bool("coverage", false, "print how often each type was chosen")
 	smitherOptMap = map[string]sqlsmith.SmitherOption{
 		"DisableMutations":                        sqlsmith.DisableMutations(),
 		"DisableDDLs":                             sqlsmith.DisableDDLs(),
 		"OnlyNoDropDDLs":                          sqlsmith.OnlyNoDropDDLs(),
+		"DDLHeavy":                                sqlsmith.DDLHeavy(),
+		"FavorNewTypes":                           sqlsmith.FavorNewTypes(),
 		"MultiRegionDDLs":                         sqlsmith.MultiRegionDDLs(),
 		"DisableWith":                             sqlsmith.DisableWith(),
 		"DisableNondeterministicFns":              sqlsmith.DisableNondeterministicFns(),
@@ -119,6 +124,18 @@ func main() {
 		}
 	}
 
+	// Production weights are applied last so that they take precedence over
+	// the weights set by the options above.
+	if *weights != "" {
+		opt, err := loadWeights(*weights)
+		if err != nil {
+			fmt.Fprintf(flags.Output(), "could not load weights: %v\n", err)
+			os.Exit(2)
+		}
+		fmt.Print("-- ", opt, "\n")
+		smitherOpts = append(smitherOpts, opt)
+	}
+
 	// Connect to an external database for schema information.
 	var db *gosql.DB
 	if *url != "" {
@@ -156,4 +173,30 @@ func main() {
 			fmt.Print("\n", smither.Generate(), ";\n")
 		}
 	}
+
+	if *coverage {
+		typeCoverage := smither.TypeCoverage()
+		typs := make([]string, 0, len(typeCoverage))
+		for typ := range typeCoverage {
+			typs = append(typs, typ)
+		}
+		sort.Strings(typs)
+		fmt.Println("\n-- type coverage")
+		for _, typ := range typs {
+			fmt.Print("-- ", typ, ": ", typeCoverage[typ], "\n")
+		}
+	}
+}
+
+// loadWeights reads a sqlsmith.Weights from the JSON file at path.
+func loadWeights(path string) (sqlsmith.SmitherOption, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var w sqlsmith.Weights
+	if err := json.Unmarshal(b, &w); err != nil {
+		return nil, errors.Wrapf(err, "parsing %s", path)
+	}
+	return sqlsmith.SetWeights(w)
 }
@@ -0,0 +1,154 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// bisectConfig configures the bisection of new failures. Bisection is
+// disabled if good is empty.
+type bisectConfig struct {
+	// repo is the cockroach git checkout whose HEAD is bisected.
+	repo string
+	// good is a commit at which the failures are known not to happen.
+	good string
+	// build is the shell command that builds cockroach in the checkout.
+	build string
+	// binary is the path of the binary produced by build, relative to the
+	// checkout.
+	binary string
+}
+
+// pendingBisection is a new failure that is bisected, and whose issue is
+// opened, once the testers stop.
+type pendingBisection struct {
+	// failure is the index of the failure in failures.
+	failure int
+	message string
+	// pattern matches the output of cockroach demo when the failure
+	// reproduces.
+	pattern string
+	stmt    string
+	reduced string
+	stack   string
+}
+
+// pendingBisections lists the failures to bisect once the testers stop. It is
+// protected by lock.
+var pendingBisections []pendingBisection
+
+// runBisections bisects the pending failures one at a time, and opens an
+// issue for each of them. A failure that can't be bisected still gets an
+// issue.
+func (s WorkerSetup) runBisections(ctx context.Context) error {
+	lock.Lock()
+	pending := pendingBisections
+	pendingBisections = nil
+	lock.Unlock()
+	for _, p := range pending {
+		fmt.Println("bisecting", p.message)
+		commit, err := s.bisect.bisect(ctx, p.reduced, p.pattern)
+		if err != nil {
+			fmt.Println("bisect failed:", err)
+		} else {
+			fmt.Println("bisected to", commit)
+			lock.Lock()
+			failures[p.failure].Bisected = commit
+			lock.Unlock()
+		}
+		if err := openIssue(p.message, p.stmt, p.reduced, p.stack, commit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var firstBadCommitRE = regexp.MustCompile(`(?m)^([0-9a-f]+) is the first bad commit$`)
+
+// bisect finds the first commit between the good commit and HEAD of the
+// repository at which running sql produces output that matches pattern. It
+// checks out commits in a temporary worktree, leaving the repository's own
+// checkout untouched, and returns the hash and subject of the first bad
+// commit. Commits that fail to build are skipped. Since it rebuilds cockroach
+// several times, this can take a long time.
+func (b bisectConfig) bisect(ctx context.Context, sql, pattern string) (string, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return "", err
+	}
+	dir, err := os.MkdirTemp("", "smithtest-bisect")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(dir)
+	if _, err := git(ctx, b.repo, "worktree", "add", "--detach", dir, "HEAD"); err != nil {
+		return "", err
+	}
+	defer func() {
+		// Use a fresh context so the worktree is removed even if ctx was
+		// canceled.
+		if _, err := git(context.Background(), b.repo, "worktree", "remove", "--force", dir); err != nil {
+			fmt.Println("could not remove bisect worktree:", err)
+		}
+	}()
+
+	out, err := git(ctx, dir, "bisect", "start", "HEAD", b.good)
+	if err != nil {
+		return "", err
+	}
+	for {
+		if match := firstBadCommitRE.FindStringSubmatch(out); match != nil {
+			return git(ctx, dir, "log", "-1", "--format=%h %s", match[1])
+		}
+		if strings.Contains(out, "only 'skip'ped commits left") {
+			return "", errors.Newf("could not find the first bad commit:\n%s", out)
+		}
+		verdict := "bad"
+		build := exec.CommandContext(ctx, "bash", "-c", b.build)
+		build.Dir = dir
+		if buildOut, err := build.CombinedOutput(); err != nil {
+			if ctx.Err() != nil {
+				return "", ctx.Err()
+			}
+			fmt.Printf("bisect: build failed, skipping commit: %v\n%s\n", err, buildOut)
+			verdict = "skip"
+		} else if ok, err := reproduces(ctx, filepath.Join(dir, b.binary), sql, re); err != nil {
+			return "", err
+		} else if !ok {
+			verdict = "good"
+		}
+		fmt.Println("bisect:", verdict)
+		if out, err = git(ctx, dir, "bisect", verdict); err != nil {
+			return "", err
+		}
+	}
+}
+
+// git runs git with the given arguments in dir and returns its trimmed
+// output.
+func git(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", errors.Wrapf(err, "git %s: %s", strings.Join(args, " "), out)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
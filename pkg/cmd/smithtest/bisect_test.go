@@ -0,0 +1,64 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package main
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/testutils/skip"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCockroach is a script that stands in for the cockroach binary in tests.
+// The %s is replaced with what it prints when running the statements.
+const fakeCockroach = `#!/bin/sh
+echo "%s"
+`
+
+func TestBisect(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		skip.IgnoreLint(t, "git not found")
+	}
+	ctx := context.Background()
+	repo := t.TempDir()
+	commit := func(file, contents, subject string) {
+		path := filepath.Join(repo, file)
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0755))
+		_, err := git(ctx, repo, "add", file)
+		require.NoError(t, err)
+		_, err = git(ctx, repo, "-c", "user.name=test", "-c", "user.email=test@example.com",
+			"commit", "-m", subject)
+		require.NoError(t, err)
+	}
+	_, err := git(ctx, repo, "init")
+	require.NoError(t, err)
+	commit("cockroach", strings.Replace(fakeCockroach, "%s", "ok", 1), "add cockroach")
+	good, err := git(ctx, repo, "rev-parse", "HEAD")
+	require.NoError(t, err)
+	commit("README", "readme", "add readme")
+	commit("cockroach", strings.Replace(fakeCockroach, "%s", "panic: boom", 1), "introduce panic")
+	commit("README", "more readme", "update readme")
+
+	b := bisectConfig{repo: repo, good: good, build: "true", binary: "cockroach"}
+	bisected, err := b.bisect(ctx, "SELECT 1", "panic: boom")
+	require.NoError(t, err)
+	require.True(t, strings.HasSuffix(bisected, " introduce panic"), "bisected to %s", bisected)
+
+	// The worktree used for bisecting is removed.
+	worktrees, err := git(ctx, repo, "worktree", "list")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(strings.Split(worktrees, "\n")), worktrees)
+}
@@ -23,11 +23,15 @@ import (
 	"io"
 	"log"
 	"math/rand"
+	"net/http"
 	"net/url"
 	"os"
 	"os/exec"
+	"os/signal"
 	"regexp"
 	"strings"
+	"sync/atomic"
+	"syscall"
 	"time"
 
 	"github.com/cockroachdb/cockroach/pkg/internal/sqlsmith"
@@ -43,19 +47,32 @@ import (
 )
 
 var (
-	flags     = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
-	cockroach = flags.String("cockroach", "./cockroach", "path to cockroach binary")
-	reduce    = flags.String("reduce", "./bin/reduce", "path to reduce binary")
-	num       = flags.Int("num", 1, "number of parallel testers")
+	flags       = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	cockroach   = flags.String("cockroach", "./cockroach", "path to cockroach binary")
+	reduce      = flags.String("reduce", "./bin/reduce", "path to reduce binary")
+	num         = flags.Int("num", 1, "number of parallel testers")
+	corpus      = flags.String("corpus", "", "directory of statements to seed sqlsmith with; statements that cause failures are added to it")
+	duration    = flags.Duration("duration", 0, "how long to run for; 0 runs until interrupted")
+	resultsPath = flags.String("results", "", "path of a JSON file to write a summary of the results to")
+	stmtTimeout = flags.Duration("stmt-timeout", 10*time.Second, "time after which a statement is considered hung")
+	artifacts   = flags.String("artifacts", "", "if set, directory to save new failures to, see the replay command")
+	bisectGood  = flags.String("bisect-good", "", "if set, a commit without the failures; new failures are bisected between it and HEAD of -bisect-repo once the testers stop, which can take a long time after -duration elapses")
+	bisectRepo  = flags.String("bisect-repo", ".", "cockroach git checkout used for bisecting")
+	bisectBuild = flags.String("bisect-build", "./dev build short", "command that builds cockroach in -bisect-repo")
+	bisectBin   = flags.String("bisect-binary", "cockroach-short", "path of the binary built by -bisect-build, relative to -bisect-repo")
 )
 
 func usage() {
-	fmt.Fprintf(flags.Output(), "Usage of %s:\n", os.Args[0])
+	fmt.Fprintf(flags.Output(), "Usage of %[1]s:\n  %[1]s [options]\n  %[1]s replay [options] <artifact-dir>\n", os.Args[0])
 	flags.PrintDefaults()
 	os.Exit(1)
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "replay" {
+		replayMain(os.Args[2:])
+		return
+	}
 	if err := flags.Parse(os.Args[1:]); err != nil {
 		usage()
 	}
@@ -64,7 +81,15 @@ func main() {
 	setup := WorkerSetup{
 		cockroach: *cockroach,
 		reduce:    *reduce,
+		corpus:    *corpus,
+		artifacts: *artifacts,
 		github:    github.NewClient(nil),
+		bisect: bisectConfig{
+			repo:   *bisectRepo,
+			good:   *bisectGood,
+			build:  *bisectBuild,
+			binary: *bisectBin,
+		},
 	}
 	rand.Seed(timeutil.Now().UnixNano())
 
@@ -72,19 +97,75 @@ func main() {
 
 	fmt.Println("running...")
 
-	g := ctxgroup.WithContext(ctx)
+	// Stop the testers on SIGINT or SIGTERM the same way as when the duration
+	// elapses, so that the results are still written. After the first signal,
+	// or once the testers have stopped, the default behavior is restored so
+	// that a signal kills smithtest, for example while it is bisecting.
+	sigCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-sigCtx.Done()
+		stop()
+	}()
+
+	start := timeutil.Now()
+	workCtx := sigCtx
+	if *duration > 0 {
+		var cancel context.CancelFunc
+		workCtx, cancel = context.WithTimeout(sigCtx, *duration)
+		defer cancel()
+	}
+	g := ctxgroup.WithContext(workCtx)
 	for i := 0; i < *num; i++ {
 		g.GoCtx(setup.work)
 	}
-	if err := g.Wait(); err != nil {
+	err := g.Wait()
+	stop()
+	maybeWriteResults := func() {
+		if *resultsPath == "" {
+			return
+		}
+		if err := writeResults(*resultsPath, timeutil.Since(start)); err != nil {
+			log.Printf("could not write results: %v", err)
+		}
+	}
+	maybeWriteResults()
+	if err == nil && len(pendingBisections) > 0 {
+		// The testers have stopped, so the failures they found can be bisected
+		// without holding them up. Bisecting isn't limited by -duration. The
+		// results are rewritten afterwards to include the bisected commits.
+		err = setup.runBisections(ctx)
+		maybeWriteResults()
+	}
+	if err != nil {
 		log.Fatalf("%+v", err)
 	}
 }
 
+// replayMain implements the replay command, which re-runs a failure saved by
+// -artifacts against a fresh cockroach instance. It exits with status 1 if
+// the failure still reproduces, and 0 if it doesn't.
+func replayMain(args []string) {
+	if err := flags.Parse(args); err != nil || flags.NArg() != 1 {
+		usage()
+	}
+	dir := flags.Arg(0)
+	ok, err := replay(context.Background(), *cockroach, dir)
+	if err != nil {
+		log.Fatalf("%+v", err)
+	}
+	if ok {
+		fmt.Println("failure reproduces:", dir)
+		os.Exit(1)
+	}
+	fmt.Println("failure does not reproduce:", dir)
+}
+
 // WorkerSetup contains initialization and configuration for running smithers.
 type WorkerSetup struct {
-	cockroach, reduce string
-	github            *github.Client
+	cockroach, reduce, corpus, artifacts string
+	github                               *github.Client
+	bisect                               bisectConfig
 }
 
 // populateGitHubIssues populates seen with issues already in GitHub.
@@ -110,11 +191,17 @@ func (s WorkerSetup) populateGitHubIssues(ctx context.Context) {
 
 func (s WorkerSetup) work(ctx context.Context) error {
 	rnd := rand.New(rand.NewSource(rand.Int63()))
-	for {
+	for ctx.Err() == nil {
 		if err := s.run(ctx, rnd); err != nil {
+			if ctx.Err() != nil {
+				// The run was interrupted because the duration elapsed or
+				// smithtest received a signal.
+				return nil
+			}
 			return err
 		}
 	}
+	return nil
 }
 
 var (
@@ -128,12 +215,20 @@ var (
 	lock syncutil.RWMutex
 	// seenIssues tracks the seen github issues.
 	seenIssues = map[string]bool{}
+	// seenSignatures tracks the stack signatures of the failures found so
+	// far. It catches duplicates whose messages differ in ways that
+	// filterIssueTitle doesn't know about.
+	seenSignatures = map[string]bool{}
 
 	connRE         = regexp.MustCompile(`(?m)^sql:\s*(postgresql://.*)$`)
+	webUIRE        = regexp.MustCompile(`(?m)^webui:\s*(https?://.*)$`)
 	panicRE        = regexp.MustCompile(`(?m)^(panic: .*?)( \[recovered\])?$`)
 	stackRE        = regexp.MustCompile(`panic: .*\n\ngoroutine \d+ \[running\]:\n(?s:(.*))$`)
 	fatalRE        = regexp.MustCompile(`(?m)^(fatal error: .*?)$`)
 	runtimeStackRE = regexp.MustCompile(`goroutine \d+ \[running\]:\n(?s:(.*?))\n\n`)
+	frameArgsRE    = regexp.MustCompile(`\([^()]*\)$`)
+	closureRE      = regexp.MustCompile(`\.func\d+(\.\d+)*$`)
+	errorFrameRE   = regexp.MustCompile(`^(\S+):\d+: (\S+)$`)
 )
 
 // run is a single sqlsmith worker. It starts a new sqlsmither and in-memory
@@ -161,6 +256,7 @@ func (s WorkerSetup) run(ctx context.Context, rnd *rand.Rand) error {
 	// Look for the connection string.
 	var pgdb *pgx.Conn
 	var db *gosql.DB
+	var webUI string
 	var output bytes.Buffer
 
 	stderr, err := cmd.StderrPipe()
@@ -174,6 +270,9 @@ func (s WorkerSetup) run(ctx context.Context, rnd *rand.Rand) error {
 	scanner := bufio.NewScanner(io.TeeReader(stderr, &output))
 	for scanner.Scan() {
 		line := scanner.Text()
+		if match := webUIRE.FindStringSubmatch(line); match != nil {
+			webUI = match[1]
+		}
 		if match := connRE.FindStringSubmatch(line); match != nil {
 			config, err := pgx.ParseConfig(match[1])
 			if err != nil {
@@ -214,6 +313,21 @@ func (s WorkerSetup) run(ctx context.Context, rnd *rand.Rand) error {
 	opts := append([]sqlsmith.SmitherOption{
 		sqlsmith.DisableMutations(),
 	}, setting.Options...)
+	if s.corpus != "" {
+		// The corpus is re-read by every run so that it includes the
+		// statements added by the other workers.
+		lock.RLock()
+		stmts, err := sqlsmith.ReadCorpus(s.corpus)
+		lock.RUnlock()
+		if err != nil {
+			return errors.Wrap(err, "read corpus")
+		}
+		opt, err := sqlsmith.SeedCorpus(stmts)
+		if err != nil {
+			return errors.Wrap(err, "seed corpus")
+		}
+		opts = append(opts, opt)
+	}
 	smither, err := sqlsmith.NewSmither(db, rnd, opts...)
 	if err != nil {
 		return errors.Wrap(err, "new smither")
@@ -235,13 +349,18 @@ func (s WorkerSetup) run(ctx context.Context, rnd *rand.Rand) error {
 		// Timeout slow statements by returning, which will cancel the
 		// command's context by the above defer.
 		select {
-		case <-time.After(10 * time.Second):
+		case <-time.After(*stmtTimeout):
 			fmt.Printf("TIMEOUT:\n%s\n", stmt)
 			lock.RUnlock()
+			s.hang(ctx, webUI, stmt)
 			return nil
 		case <-done:
 		}
 		lock.RUnlock()
+		if ctx.Err() != nil {
+			return nil
+		}
+		atomic.AddInt64(&numStatements, 1)
 		if err != nil {
 			if strings.Contains(err.Error(), "internal error") {
 				// Return from this function on internal
@@ -261,23 +380,25 @@ func (s WorkerSetup) run(ctx context.Context, rnd *rand.Rand) error {
 		if err := db.PingContext(ctx); err != nil {
 			input := fmt.Sprintf("%s; %s;", initSQL, stmt)
 			out, _ := exec.CommandContext(ctx, s.cockroach, "demo", "--no-example-database", "-e", input).CombinedOutput()
-			var pqerr pq.Error
+			// Report the crash like the errors returned by pgdb, so that
+			// failure finds the stack in the Detail.
+			var pgErr pgconn.PgError
 			if match := stackRE.FindStringSubmatch(string(out)); match != nil {
-				pqerr.Detail = strings.TrimSpace(match[1])
+				pgErr.Detail = strings.TrimSpace(match[1])
 			}
 			if match := panicRE.FindStringSubmatch(string(out)); match != nil {
 				// We found a panic as expected.
-				pqerr.Message = match[1]
-				return s.failure(ctx, initSQL, stmt, &pqerr)
+				pgErr.Message = match[1]
+				return s.failure(ctx, initSQL, stmt, &pgErr)
 			}
 			// Not a panic. Maybe a fatal?
 			if match := runtimeStackRE.FindStringSubmatch(string(out)); match != nil {
-				pqerr.Detail = strings.TrimSpace(match[1])
+				pgErr.Detail = strings.TrimSpace(match[1])
 			}
 			if match := fatalRE.FindStringSubmatch(string(out)); match != nil {
 				// A real bad non-panic error.
-				pqerr.Message = match[1]
-				return s.failure(ctx, initSQL, stmt, &pqerr)
+				pgErr.Message = match[1]
+				return s.failure(ctx, initSQL, stmt, &pgErr)
 			}
 			// A panic was not found. Shut everything down by returning an error so it can be investigated.
 			fmt.Printf("output:\n%s\n", out)
@@ -291,14 +412,7 @@ func (s WorkerSetup) run(ctx context.Context, rnd *rand.Rand) error {
 // indicating that this was successfully filed and we should continue looking
 // for errors.
 func (s WorkerSetup) failure(ctx context.Context, initSQL []string, stmt string, err error) error {
-	var message, stack string
-	var pqerr pgconn.PgError
-	if errors.As(err, &pqerr) {
-		stack = pqerr.Detail
-		message = pqerr.Message
-	} else {
-		message = err.Error()
-	}
+	kind, message, stack := classifyFailure(err)
 	filteredMessage := filterIssueTitle(regexp.QuoteMeta(message))
 	message = fmt.Sprintf("sql: %s", message)
 
@@ -307,16 +421,47 @@ func (s WorkerSetup) failure(ctx context.Context, initSQL []string, stmt string,
 	// tests won't run during the reducer, and only one reducer can run
 	// at once.
 	defer lock.Unlock()
+	if s.corpus != "" {
+		if err := sqlsmith.WriteCorpus(s.corpus, stmt); err != nil {
+			return errors.Wrap(err, "write corpus")
+		}
+	}
 	sqlFilteredMessage := fmt.Sprintf("sql: %s", filteredMessage)
 	alreadySeen := seenIssues[sqlFilteredMessage]
 	if !alreadySeen {
 		seenIssues[sqlFilteredMessage] = true
 	}
+	sig := stackSignature(stack)
+	if sig != "" {
+		alreadySeen = alreadySeen || seenSignatures[sig]
+		seenSignatures[sig] = true
+	}
+	res := failureResult{
+		Kind:      kind,
+		Message:   message,
+		Signature: sig,
+		Statement: stmt,
+		Duplicate: alreadySeen,
+		Reduction: reductionSkipped,
+	}
+	// This runs before the lock is released.
+	defer func() { failures = append(failures, res) }()
 	if alreadySeen {
 		fmt.Println("already found", message)
 		return nil
 	}
 	fmt.Println("found", message)
+	if s.artifacts != "" {
+		path, err := saveArtifact(s.artifacts, initSQL, stmt, artifactFailure{
+			Kind:    kind,
+			Message: message,
+			Pattern: filteredMessage,
+		})
+		if err != nil {
+			return errors.Wrap(err, "save artifact")
+		}
+		fmt.Println("saved failure to", path)
+	}
 	input := fmt.Sprintf("%s\n\n%s;", strings.Join(initSQL, "\n"), stmt)
 	fmt.Printf("SQL:\n%s\n\n", input)
 
@@ -328,12 +473,57 @@ func (s WorkerSetup) failure(ctx context.Context, initSQL []string, stmt string,
 	cmd.Stdout = &out
 	if err := cmd.Run(); err != nil {
 		fmt.Println(input)
+		res.Reduction = reductionFailed
 		return err
 	}
+	res.Reduction = reductionSucceeded
+	res.Reduced = strings.TrimSpace(out.String())
+
+	if s.bisect.good != "" {
+		// Bisecting rebuilds cockroach many times. Doing it here, with lock
+		// held, would stall all the workers for that long, so it is queued
+		// until they stop, and the issue is opened after it.
+		pendingBisections = append(pendingBisections, pendingBisection{
+			failure: len(failures),
+			message: message,
+			pattern: filteredMessage,
+			stmt:    stmt,
+			reduced: res.Reduced,
+			stack:   stack,
+		})
+		fmt.Println("queued bisection of", message)
+		return nil
+	}
+	return openIssue(message, stmt, res.Reduced, stack, "" /* bisected */)
+}
+
+// classifyFailure returns the kind of a failure along with its message and
+// stack. Errors returned by pgdb and crashes found by the worker both carry
+// the message and the stack in a *pgconn.PgError; the message is taken from
+// it rather than from err.Error(), which has an "ERROR: " prefix and a
+// SQLSTATE suffix that would hide the "panic: " and "fatal error: " prefixes.
+func classifyFailure(err error) (kind, message, stack string) {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		message, stack = pgErr.Message, pgErr.Detail
+	} else {
+		message = err.Error()
+	}
+	kind = kindInternalError
+	if strings.HasPrefix(message, "panic: ") || strings.HasPrefix(message, "fatal error: ") {
+		kind = kindCrash
+	}
+	return kind, message, stack
+}
 
-	// Generate the pre-filled github issue.
+// openIssue opens a pre-filled GitHub issue for a failure in the browser.
+func openIssue(message, stmt, reduced, stack, bisected string) error {
 	makeBody := func() string {
-		return fmt.Sprintf("```\n%s\n```\n\n```\n%s\n```", strings.TrimSpace(out.String()), strings.TrimSpace(stack))
+		body := fmt.Sprintf("```\n%s\n```\n\n```\n%s\n```", reduced, strings.TrimSpace(stack))
+		if bisected != "" {
+			body = fmt.Sprintf("Bisected to %s.\n\n%s", bisected, body)
+		}
+		return body
 	}
 	query := url.Values{
 		"title":  []string{message},
@@ -363,11 +553,108 @@ func (s WorkerSetup) failure(ctx context.Context, initSQL []string, stmt string,
 		return errors.New("request could not be shortened to max length")
 	}
 
-	if err := browser.OpenURL(url.String()); err != nil {
-		return err
+	return browser.OpenURL(url.String())
+}
+
+// hang records a statement that didn't finish within the statement timeout,
+// along with a dump of the goroutines of the cockroach node at webUI. Hangs
+// can't be reduced like crashes since they take a long time to reproduce, so
+// they are only recorded in the results and the dump is written to a
+// temporary file for investigation.
+func (s WorkerSetup) hang(ctx context.Context, webUI, stmt string) {
+	res := failureResult{
+		Kind:      kindHang,
+		Message:   fmt.Sprintf("statement did not finish after %s", *stmtTimeout),
+		Statement: stmt,
+		Reduction: reductionSkipped,
+	}
+	if path, err := dumpGoroutines(ctx, webUI); err != nil {
+		fmt.Println("could not dump goroutines:", err)
+	} else {
+		fmt.Println("goroutines dumped to", path)
+		res.Goroutines = path
 	}
+	lock.Lock()
+	defer lock.Unlock()
+	failures = append(failures, res)
+}
 
-	return nil
+// dumpGoroutines writes the goroutine stacks of the cockroach node at webUI
+// to a new temporary file, and returns its path.
+func dumpGoroutines(ctx context.Context, webUI string) (string, error) {
+	if webUI == "" {
+		return "", errors.New("no web UI address found")
+	}
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, "GET", webUI+"/debug/pprof/goroutine?debug=2", nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Newf("unexpected status %s", resp.Status)
+	}
+	f, err := os.CreateTemp("", "smithtest-hang-*.txt")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// numSignatureFrames is the number of innermost stack frames that make up a
+// stack signature.
+const numSignatureFrames = 5
+
+// stackSignature returns a signature of the given stack trace that is stable
+// across runs and builds: it consists of the names of the innermost
+// functions, excluding the runtime's panic machinery, with the arguments,
+// file names, line numbers and goroutine IDs stripped. It returns the empty
+// string if no frames are found.
+//
+// Both Go stack traces and the stack traces pgerror adds to internal errors
+// are supported.
+func stackSignature(stack string) string {
+	var frames []string
+	for _, line := range strings.Split(stack, "\n") {
+		if len(frames) == numSignatureFrames {
+			break
+		}
+		// Lines starting with whitespace contain the file and line number of
+		// the preceding frame.
+		if line == "" || line[0] == ' ' || line[0] == '\t' || line == "stack trace:" {
+			continue
+		}
+		line = strings.TrimSpace(line)
+		// The frames of pgerror stack traces are formatted as
+		// "sorter.go:121: (*sorter).Next()".
+		if match := errorFrameRE.FindStringSubmatch(line); match != nil {
+			if strings.Contains(match[1], "src/runtime/") {
+				continue
+			}
+			line = match[2]
+		}
+		if strings.HasPrefix(line, "goroutine ") ||
+			strings.HasPrefix(line, "created by ") ||
+			strings.HasPrefix(line, "panic(") ||
+			strings.HasPrefix(line, "runtime.") {
+			continue
+		}
+		line = frameArgsRE.ReplaceAllString(line, "")
+		// Closures are numbered in the order they appear in the enclosing
+		// function, which changes as unrelated code is edited.
+		line = closureRE.ReplaceAllString(line, ".funcN")
+		frames = append(frames, line)
+	}
+	return strings.Join(frames, "\n")
 }
 
 // filterIssueTitle handles issue title where some words in the title can
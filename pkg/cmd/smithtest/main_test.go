@@ -0,0 +1,155 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStackSignature(t *testing.T) {
+	const panicStack = `goroutine 1234 [running]:
+panic({0x5a1b2c0, 0xc001234560})
+	GOROOT/src/runtime/panic.go:838 +0x207
+github.com/cockroachdb/cockroach/pkg/sql/sem/tree.(*DInt).Compare(0xc000aa0000, {0x6b1c2d0, 0xc000bb0000}, {0x6b2d3e0?, 0xc000cc0000?})
+	github.com/cockroachdb/cockroach/pkg/sql/sem/tree/datum.go:1032 +0x1a5
+github.com/cockroachdb/cockroach/pkg/sql/rowexec.(*sorter).Next.func2(...)
+	github.com/cockroachdb/cockroach/pkg/sql/rowexec/sorter.go:121
+github.com/cockroachdb/cockroach/pkg/sql/rowexec.(*sorter).Next.func2.1()
+	github.com/cockroachdb/cockroach/pkg/sql/rowexec/sorter.go:125 +0x45
+runtime.gopanic({0x5a1b2c0, 0xc001234560})
+	GOROOT/src/runtime/panic.go:838 +0x207
+github.com/cockroachdb/cockroach/pkg/sql/rowexec.(*sorter).Next(0xc000dd0000)
+	github.com/cockroachdb/cockroach/pkg/sql/rowexec/sorter.go:130 +0x8c
+github.com/cockroachdb/cockroach/pkg/sql/execinfra.Run({0x6c3e4f0, 0xc000ee0000}, {0x6c4f500, 0xc000dd0000}, {0x6c50610, 0xc000ff0000})
+	github.com/cockroachdb/cockroach/pkg/sql/execinfra/base.go:186 +0x5e
+github.com/cockroachdb/cockroach/pkg/sql/flowinfra.(*FlowBase).Run(0xc001000000, {0x6c3e4f0, 0xc000ee0000}, 0x0)
+	github.com/cockroachdb/cockroach/pkg/sql/flowinfra/flow.go:514 +0x2a5
+created by github.com/cockroachdb/cockroach/pkg/sql/flowinfra.(*FlowBase).StartInternal
+	github.com/cockroachdb/cockroach/pkg/sql/flowinfra/flow.go:403 +0x3c5`
+
+	const expected = `github.com/cockroachdb/cockroach/pkg/sql/sem/tree.(*DInt).Compare
+github.com/cockroachdb/cockroach/pkg/sql/rowexec.(*sorter).Next.funcN
+github.com/cockroachdb/cockroach/pkg/sql/rowexec.(*sorter).Next.funcN
+github.com/cockroachdb/cockroach/pkg/sql/rowexec.(*sorter).Next
+github.com/cockroachdb/cockroach/pkg/sql/execinfra.Run`
+
+	// errorStack is the Detail that pgerror adds to internal errors.
+	const errorStack = `stack trace:
+github.com/cockroachdb/cockroach/pkg/sql/sem/tree/datum.go:1032: (*DInt).Compare()
+github.com/cockroachdb/cockroach/pkg/sql/rowexec/sorter.go:121: (*sorter).Next.func2()
+GOROOT/src/runtime/panic.go:838: gopanic()
+github.com/cockroachdb/cockroach/pkg/sql/rowexec/sorter.go:130: (*sorter).Next()
+github.com/cockroachdb/cockroach/pkg/sql/execinfra/base.go:186: Run()
+github.com/cockroachdb/cockroach/pkg/sql/flowinfra/flow.go:514: (*FlowBase).Run()
+`
+
+	const expectedError = `(*DInt).Compare
+(*sorter).Next.funcN
+(*sorter).Next
+Run
+(*FlowBase).Run`
+
+	for _, tc := range []struct {
+		name     string
+		stack    string
+		expected string
+	}{
+		{name: "empty", stack: "", expected: ""},
+		{name: "only runtime frames", stack: "goroutine 1 [running]:\nruntime.throw({0x1, 0x2})\n\tGOROOT/src/runtime/panic.go:992 +0x71", expected: ""},
+		{name: "panic", stack: panicStack, expected: expected},
+		{
+			// The signature doesn't depend on the goroutine ID, the arguments,
+			// the line numbers or the closure numbers.
+			name: "panic in another build",
+			stack: `goroutine 99 [running]:
+panic({0x1, 0x2})
+	GOROOT/src/runtime/panic.go:840 +0x1
+github.com/cockroachdb/cockroach/pkg/sql/sem/tree.(*DInt).Compare(0x1, {0x2, 0x3}, {0x4, 0x5})
+	github.com/cockroachdb/cockroach/pkg/sql/sem/tree/datum.go:1040 +0x1
+github.com/cockroachdb/cockroach/pkg/sql/rowexec.(*sorter).Next.func3(...)
+	github.com/cockroachdb/cockroach/pkg/sql/rowexec/sorter.go:131
+github.com/cockroachdb/cockroach/pkg/sql/rowexec.(*sorter).Next.func3.2()
+	github.com/cockroachdb/cockroach/pkg/sql/rowexec/sorter.go:135 +0x1
+github.com/cockroachdb/cockroach/pkg/sql/rowexec.(*sorter).Next(0x1)
+	github.com/cockroachdb/cockroach/pkg/sql/rowexec/sorter.go:140 +0x1
+github.com/cockroachdb/cockroach/pkg/sql/execinfra.Run({0x1, 0x2}, {0x3, 0x4}, {0x5, 0x6})
+	github.com/cockroachdb/cockroach/pkg/sql/execinfra/base.go:190 +0x1`,
+			expected: expected,
+		},
+		{name: "internal error", stack: errorStack, expected: expectedError},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, stackSignature(tc.stack))
+		})
+	}
+
+	t.Run("internal error detail", func(t *testing.T) {
+		_, _, stack := classifyFailure(&pgconn.PgError{
+			Severity: "ERROR", Code: "XX000", Message: "internal error: boom", Detail: errorStack,
+		})
+		assert.Equal(t, expectedError, stackSignature(stack))
+	})
+}
+
+func TestClassifyFailure(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		err     error
+		kind    string
+		message string
+		stack   string
+	}{
+		{
+			name:    "internal error",
+			err:     &pgconn.PgError{Severity: "ERROR", Code: "XX000", Message: "internal error: unexpected type", Detail: "stack trace:\nfoo.go:1"},
+			kind:    kindInternalError,
+			message: "internal error: unexpected type",
+			stack:   "stack trace:\nfoo.go:1",
+		},
+		{
+			name:    "panic",
+			err:     &pgconn.PgError{Message: "panic: runtime error: index out of range", Detail: "main.main()"},
+			kind:    kindCrash,
+			message: "panic: runtime error: index out of range",
+			stack:   "main.main()",
+		},
+		{
+			name:    "fatal error",
+			err:     &pgconn.PgError{Message: "fatal error: concurrent map writes"},
+			kind:    kindCrash,
+			message: "fatal error: concurrent map writes",
+		},
+		{
+			// err.Error() would be "context: ERROR: panic: boom (SQLSTATE XX000)".
+			name:    "wrapped",
+			err:     errors.Wrap(&pgconn.PgError{Severity: "ERROR", Code: "XX000", Message: "panic: boom"}, "context"),
+			kind:    kindCrash,
+			message: "panic: boom",
+		},
+		{
+			name:    "not a pgconn error",
+			err:     errors.New("internal error: boom"),
+			kind:    kindInternalError,
+			message: "internal error: boom",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			kind, message, stack := classifyFailure(tc.err)
+			assert.Equal(t, tc.kind, kind)
+			assert.Equal(t, tc.message, message)
+			assert.Equal(t, tc.stack, stack)
+		})
+	}
+}
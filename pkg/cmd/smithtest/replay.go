@@ -0,0 +1,129 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// A failure artifact is a directory containing the files below, which are
+// enough to reproduce a failure on a fresh cockroach instance.
+const (
+	// artifactSetupFile contains the statements that set up the schema and
+	// data.
+	artifactSetupFile = "setup.sql"
+	// artifactStmtFile contains the failing statement.
+	artifactStmtFile = "stmt.sql"
+	// artifactFailureFile contains the JSON encoded artifactFailure.
+	artifactFailureFile = "failure.json"
+)
+
+// artifactFailure describes the failure saved in an artifact.
+type artifactFailure struct {
+	Kind    string `json:"kind"`
+	Message string `json:"message"`
+	// Pattern is the regular expression that the output of cockroach demo
+	// matches when the failure reproduces.
+	Pattern string `json:"pattern"`
+}
+
+// saveArtifact saves a failure artifact in a new subdirectory of dir and
+// returns its path.
+func saveArtifact(dir string, initSQL []string, stmt string, f artifactFailure) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	path, err := os.MkdirTemp(dir, "failure-")
+	if err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	for name, contents := range map[string]string{
+		artifactSetupFile:   strings.Join(initSQL, "\n") + "\n",
+		artifactStmtFile:    stmt + ";\n",
+		artifactFailureFile: string(b) + "\n",
+	} {
+		if err := os.WriteFile(filepath.Join(path, name), []byte(contents), 0644); err != nil {
+			return "", err
+		}
+	}
+	return path, nil
+}
+
+// replay re-runs the failure saved in the artifact at dir against a fresh
+// cockroach demo instance started with the given binary, and returns whether
+// the failure still reproduces.
+func replay(ctx context.Context, binary, dir string) (bool, error) {
+	b, err := os.ReadFile(filepath.Join(dir, artifactFailureFile))
+	if err != nil {
+		return false, err
+	}
+	var f artifactFailure
+	if err := json.Unmarshal(b, &f); err != nil {
+		return false, errors.Wrapf(err, "parsing %s", artifactFailureFile)
+	}
+	re, err := regexp.Compile(f.Pattern)
+	if err != nil {
+		return false, err
+	}
+	setup, err := os.ReadFile(filepath.Join(dir, artifactSetupFile))
+	if err != nil {
+		return false, err
+	}
+	stmt, err := os.ReadFile(filepath.Join(dir, artifactStmtFile))
+	if err != nil {
+		return false, err
+	}
+	return reproduces(ctx, binary, string(setup)+"\n"+string(stmt), re)
+}
+
+// demoErrorRE matches the output of a cockroach demo session whose
+// statements failed or crashed the node, as opposed to a binary that
+// couldn't run the session at all, like one that doesn't know the demo
+// command or fails to start a node.
+var demoErrorRE = regexp.MustCompile(`(?m)^(ERROR: |panic: |fatal error: )`)
+
+// reproduces runs sql in a fresh cockroach demo instance started with the
+// given binary, and returns whether its output matches re. It returns an
+// error if the binary couldn't be run, or if it exited with an error that
+// doesn't come from the statements.
+func reproduces(ctx context.Context, binary, sql string, re *regexp.Regexp) (bool, error) {
+	out, err := exec.CommandContext(ctx, binary, "demo", "--no-example-database", "-e", sql).CombinedOutput()
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return false, ctxErr
+	}
+	if re.Match(out) {
+		return true, nil
+	}
+	if err != nil {
+		// Errors from the statements are expected here, since we're looking
+		// for failures.
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			return false, errors.Wrapf(err, "running %s", binary)
+		}
+		if !demoErrorRE.Match(out) {
+			return false, errors.Wrapf(err, "running %s demo:\n%s", binary, out)
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplay(t *testing.T) {
+	ctx := context.Background()
+	initSQL := []string{"CREATE TABLE t (a INT)", "INSERT INTO t VALUES (1)"}
+	path, err := saveArtifact(t.TempDir(), initSQL, "SELECT a FROM t", artifactFailure{
+		Kind:    kindCrash,
+		Message: "sql: panic: boom",
+		Pattern: `panic: boom`,
+	})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name string
+		// script is the body of the shell script standing in for the cockroach
+		// binary. The statements passed with -e are in $4.
+		script      string
+		reproduces  bool
+		expectedErr string
+	}{
+		{
+			// The script only crashes if it's given the saved statements.
+			name: "reproduces",
+			script: `case "$4" in
+*"INSERT INTO t VALUES (1)"*"SELECT a FROM t;"*) echo "panic: boom"; exit 2;;
+esac`,
+			reproduces: true,
+		},
+		{
+			name:   "fixed",
+			script: `echo "a"; echo "1"`,
+		},
+		{
+			name:   "statement error",
+			script: `echo "ERROR: relation \"t\" does not exist"; exit 1`,
+		},
+		{
+			name:        "bad binary",
+			script:      `echo "unknown command \"demo\""; exit 1`,
+			expectedErr: `unknown command "demo"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			binary := filepath.Join(t.TempDir(), "cockroach")
+			require.NoError(t, os.WriteFile(binary, []byte("#!/bin/sh\n"+tc.script+"\n"), 0755))
+			ok, err := replay(ctx, binary, path)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.reproduces, ok)
+		})
+	}
+
+	t.Run("missing binary", func(t *testing.T) {
+		_, err := replay(ctx, filepath.Join(t.TempDir(), "cockroach"), path)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,100 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"sync/atomic"
+	"time"
+)
+
+// Kinds of failures.
+const (
+	// kindCrash is a failure that crashed the node, like a panic.
+	kindCrash = "crash"
+	// kindInternalError is a statement that failed with an internal error.
+	kindInternalError = "internal error"
+	// kindHang is a statement that didn't finish within the statement
+	// timeout.
+	kindHang = "hang"
+)
+
+// Reduction statuses of a failureResult.
+const (
+	// reductionSkipped means that the failure was a duplicate, so it wasn't
+	// reduced.
+	reductionSkipped = "skipped"
+	// reductionSucceeded means that the reducer ran successfully.
+	reductionSucceeded = "succeeded"
+	// reductionFailed means that the reducer returned an error.
+	reductionFailed = "failed"
+)
+
+// results summarizes a smithtest invocation. It is written as JSON to the
+// file specified by the -results flag so that it can be consumed by other
+// tools.
+type results struct {
+	// Duration is the time smithtest ran for, in seconds.
+	Duration float64 `json:"duration"`
+	// Statements is the number of generated statements that were executed.
+	Statements int64 `json:"statements"`
+	// Failures lists the failures in the order they were found.
+	Failures []failureResult `json:"failures"`
+}
+
+// failureResult describes a single failure.
+type failureResult struct {
+	// Kind is one of the kinds of failures above.
+	Kind    string `json:"kind"`
+	Message string `json:"message"`
+	// Signature is the stack signature of the failure, see stackSignature.
+	Signature string `json:"signature,omitempty"`
+	Statement string `json:"statement"`
+	// Duplicate is true if the failure was already seen, either in this
+	// invocation or in an open GitHub issue.
+	Duplicate bool `json:"duplicate"`
+	// Reduction is one of the reduction statuses above.
+	Reduction string `json:"reduction"`
+	// Reduced is the output of the reducer if the reduction succeeded.
+	Reduced string `json:"reduced,omitempty"`
+	// Bisected is the commit that introduced the failure, if it was
+	// bisected successfully.
+	Bisected string `json:"bisected,omitempty"`
+	// Goroutines is the path of the goroutine dump taken when a statement
+	// hung.
+	Goroutines string `json:"goroutines,omitempty"`
+}
+
+var (
+	// numStatements is the number of statements executed so far. It must be
+	// accessed atomically.
+	numStatements int64
+	// failures lists the failures found so far. It is protected by lock.
+	failures []failureResult
+)
+
+// writeResults writes the results of an invocation that ran for elapsed to
+// the file at path.
+func writeResults(path string, elapsed time.Duration) error {
+	lock.RLock()
+	res := results{
+		Duration:   elapsed.Seconds(),
+		Statements: atomic.LoadInt64(&numStatements),
+		Failures:   append([]failureResult{}, failures...),
+	}
+	b, err := json.MarshalIndent(res, "", "  ")
+	lock.RUnlock()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0644)
+}
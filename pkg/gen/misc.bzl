@@ -15,6 +15,7 @@ MISC_SRCS = [
   "//pkg/util/interval/generic:example_interval_btree_test.go",
   "//pkg/util/log/channel:channel_generated.go",
   "//pkg/util/log/eventpb/eventpbgen:log_channels_generated.go",
+  "//pkg/util/log/eventpb:event_types_generated.go",
   "//pkg/util/log/eventpb:eventlog_channels_generated.go",
   "//pkg/util/log/eventpb:json_encode_generated.go",
   "//pkg/util/log/logpb:json_encode_generated.go",
@@ -12,6 +12,7 @@ package sqlsmith
 
 import (
 	gosql "database/sql"
+	"fmt"
 	"math/rand"
 
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/colinfo"
@@ -43,6 +44,12 @@ var (
 		{1, makeDropIndex},
 		{5, makeRenameIndex},
 	}
+	altersConstraints = []statementWeight{
+		{5, makeAddCheckConstraint},
+		{5, makeAddUniqueConstraint},
+		{5, makeAddForeignKey},
+		{3, makeDropConstraint},
+	}
 	altersTypeExistence = []statementWeight{
 		{5, makeCreateType},
 	}
@@ -384,6 +391,162 @@ func makeRenameIndex(s *Smither) (tree.Statement, bool) {
 	}, ok
 }
 
+func (s *Smither) randValidationBehavior() tree.ValidationBehavior {
+	if s.d6() == 1 {
+		return tree.ValidationSkip
+	}
+	return tree.ValidationDefault
+}
+
+func makeAddCheckConstraint(s *Smither) (tree.Statement, bool) {
+	_, _, tableRef, colRefs, ok := s.getSchemaTable()
+	if !ok {
+		return nil, false
+	}
+	colRefs.stripTableName()
+
+	return &tree.AlterTable{
+		Table: tableRef.TableName.ToUnresolvedObjectName(),
+		Cmds: tree.AlterTableCmds{
+			&tree.AlterTableAddConstraint{
+				ConstraintDef: &tree.CheckConstraintTableDef{
+					Name: s.name("check"),
+					Expr: makeBoolExpr(s, colRefs),
+				},
+				ValidationBehavior: s.randValidationBehavior(),
+			},
+		},
+	}, true
+}
+
+func makeAddUniqueConstraint(s *Smither) (tree.Statement, bool) {
+	_, _, tableRef, _, ok := s.getSchemaTable()
+	if !ok {
+		return nil, false
+	}
+	var cols tree.IndexElemList
+	seen := map[tree.Name]bool{}
+	for i := 0; i < len(tableRef.Columns) && (len(cols) == 0 || s.coin()); i++ {
+		col := tableRef.Columns[s.rnd.Intn(len(tableRef.Columns))]
+		if seen[col.Name] || !colinfo.ColumnTypeIsIndexable(tree.MustBeStaticallyKnownType(col.Type)) {
+			continue
+		}
+		seen[col.Name] = true
+		cols = append(cols, tree.IndexElem{Column: col.Name})
+	}
+	if len(cols) == 0 {
+		return nil, false
+	}
+
+	return &tree.AlterTable{
+		Table: tableRef.TableName.ToUnresolvedObjectName(),
+		Cmds: tree.AlterTableCmds{
+			&tree.AlterTableAddConstraint{
+				ConstraintDef: &tree.UniqueConstraintTableDef{
+					IndexTableDef: tree.IndexTableDef{
+						Name:    s.name("unique"),
+						Columns: cols,
+					},
+				},
+			},
+		},
+	}, true
+}
+
+// makeAddForeignKey adds a foreign key from a random table to the columns of
+// a random index. The referencing columns are picked among the columns of the
+// same types as the index columns. The index isn't necessarily unique, in
+// which case the schema change fails.
+func makeAddForeignKey(s *Smither) (tree.Statement, bool) {
+	_, _, tableRef, _, ok := s.getSchemaTable()
+	if !ok {
+		return nil, false
+	}
+	refTable, _, refCols, ok := s.getRandIndex()
+	if !ok {
+		return nil, false
+	}
+	var fromCols, toCols tree.NameList
+	used := map[tree.Name]bool{}
+	for _, ref := range refCols {
+		var candidates []tree.Name
+		for _, col := range tableRef.Columns {
+			if !used[col.Name] && tree.MustBeStaticallyKnownType(col.Type).Equivalent(ref.typ) {
+				candidates = append(candidates, col.Name)
+			}
+		}
+		if len(candidates) == 0 {
+			return nil, false
+		}
+		col := candidates[s.rnd.Intn(len(candidates))]
+		used[col] = true
+		fromCols = append(fromCols, col)
+		toCols = append(toCols, ref.item.ColumnName)
+	}
+	var actions tree.ReferenceActions
+	if s.coin() {
+		actions.Delete = tree.ReferenceAction(s.rnd.Intn(int(tree.Cascade + 1)))
+	}
+	if s.coin() {
+		actions.Update = tree.ReferenceAction(s.rnd.Intn(int(tree.Cascade + 1)))
+	}
+
+	return &tree.AlterTable{
+		Table: tableRef.TableName.ToUnresolvedObjectName(),
+		Cmds: tree.AlterTableCmds{
+			&tree.AlterTableAddConstraint{
+				ConstraintDef: &tree.ForeignKeyConstraintTableDef{
+					Name:     s.name("fk"),
+					Table:    refTable.Table,
+					FromCols: fromCols,
+					ToCols:   toCols,
+					Actions:  actions,
+				},
+				ValidationBehavior: s.randValidationBehavior(),
+			},
+		},
+	}, true
+}
+
+// makeDropConstraint drops a random constraint other than the primary key.
+// The Smither doesn't track constraints, so they are looked up in the
+// database.
+func makeDropConstraint(s *Smither) (tree.Statement, bool) {
+	_, _, tableRef, _, ok := s.getSchemaTable()
+	if !ok {
+		return nil, false
+	}
+	rows, err := s.db.Query(fmt.Sprintf(`
+		SELECT constraint_name
+		FROM [SHOW CONSTRAINTS FROM %s]
+		WHERE constraint_type != 'PRIMARY KEY'`, tableRef.TableName))
+	if err != nil {
+		return nil, false
+	}
+	var names []tree.Name
+	for rows.Next() {
+		var name tree.Name
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return nil, false
+		}
+		names = append(names, name)
+	}
+	if err := rows.Close(); err != nil || len(names) == 0 {
+		return nil, false
+	}
+
+	return &tree.AlterTable{
+		Table: tableRef.TableName.ToUnresolvedObjectName(),
+		Cmds: tree.AlterTableCmds{
+			&tree.AlterTableDropConstraint{
+				Constraint:   names[s.rnd.Intn(len(names))],
+				DropBehavior: s.randDropBehavior(),
+			},
+		},
+	}, true
+}
+
 func makeCreateType(s *Smither) (tree.Statement, bool) {
 	name := s.name("typ")
 	return randgen.RandCreateType(s.rnd, string(name), letters), true
@@ -0,0 +1,149 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package sqlsmith
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/parser"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/errors"
+)
+
+// A corpus is a directory of statements that carries interesting generated
+// statements from one fuzzing session to the next. Each statement is stored
+// in its own file with the corpusFileExt extension, named after a hash of its
+// contents so that exporting the same statement twice is a no-op.
+const corpusFileExt = ".sql"
+
+// ReadCorpus returns the statements stored in the corpus directory dir,
+// sorted by file name.
+func ReadCorpus(dir string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*"+corpusFileExt))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	stmts := make([]string, 0, len(files))
+	for _, file := range files {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, strings.TrimSpace(string(b)))
+	}
+	return stmts, nil
+}
+
+// WriteCorpus exports stmt to the corpus directory dir, creating the
+// directory if needed.
+func WriteCorpus(dir string, stmt string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	stmt = strings.TrimSpace(stmt)
+	sum := sha256.Sum256([]byte(stmt))
+	name := hex.EncodeToString(sum[:8]) + corpusFileExt
+	return os.WriteFile(filepath.Join(dir, name), []byte(stmt+"\n"), 0644)
+}
+
+// SeedCorpus returns a SmitherOption that causes the Smither to generate
+// about half of its statements by mutating a random statement of the given
+// corpus (see ReadCorpus) instead of generating one from scratch. An error
+// is returned if one of the statements can't be parsed.
+func SeedCorpus(stmts []string) (SmitherOption, error) {
+	for _, stmt := range stmts {
+		if _, err := parser.ParseOne(stmt); err != nil {
+			return nil, errors.Wrapf(err, "parsing corpus statement %q", stmt)
+		}
+	}
+	return option{
+		name: fmt.Sprintf("seed corpus (%d statements)", len(stmts)),
+		apply: func(s *Smither) {
+			s.corpus = stmts
+		},
+	}, nil
+}
+
+// mutateCorpusStmt picks a random statement of the corpus and replaces one
+// of its typed constants with a new random constant of the same type. It
+// returns false if the picked statement has no constants that can be
+// replaced.
+func (s *Smither) mutateCorpusStmt() (tree.Statement, bool) {
+	parsed, err := parser.ParseOne(s.corpus[s.rnd.Intn(len(s.corpus))])
+	if err != nil {
+		// The corpus was validated by SeedCorpus.
+		panic(errors.NewAssertionErrorWithWrappedErrf(err, "parsing corpus statement"))
+	}
+
+	// Count the constants, then replace a random one of them.
+	var n int
+	if _, err := tree.SimpleStmtVisit(parsed.AST, func(expr tree.Expr) (bool, tree.Expr, error) {
+		if corpusConstType(expr) != nil {
+			n++
+			return false, expr, nil
+		}
+		return true, expr, nil
+	}); err != nil || n == 0 {
+		return nil, false
+	}
+	target, i := s.rnd.Intn(n), 0
+	stmt, err := tree.SimpleStmtVisit(parsed.AST, func(expr tree.Expr) (bool, tree.Expr, error) {
+		typ := corpusConstType(expr)
+		if typ == nil {
+			return true, expr, nil
+		}
+		replace := i == target
+		i++
+		if !replace {
+			return false, expr, nil
+		}
+		return false, makeConstDatum(s, typ), nil
+	})
+	if err != nil {
+		return nil, false
+	}
+	return stmt, true
+}
+
+// corpusConstType returns the type of expr if it is a constant annotated or
+// cast with a type that doesn't need to be resolved against the database,
+// like the constants produced by the Smither. Otherwise it returns nil.
+func corpusConstType(expr tree.Expr) *types.T {
+	var inner tree.Expr
+	var ref tree.ResolvableTypeReference
+	switch t := expr.(type) {
+	case *tree.AnnotateTypeExpr:
+		inner, ref = t.Expr, t.Type
+	case *tree.CastExpr:
+		inner, ref = t.Expr, t.Type
+	default:
+		return nil
+	}
+	switch inner.(type) {
+	case tree.Constant, tree.Datum:
+	default:
+		return nil
+	}
+	typ, err := tree.ResolveType(context.Background(), ref, nil /* resolver */)
+	if err != nil || typ.UserDefined() {
+		return nil
+	}
+	return typ
+}
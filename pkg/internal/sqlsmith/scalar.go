@@ -37,9 +37,7 @@ var (
 		{5, scalarNoContext(makeOr)},
 		{5, scalarNoContext(makeNot)},
 		{10, makeFunc},
-		{10, func(s *Smither, ctx Context, typ *types.T, refs colRefs) (tree.TypedExpr, bool) {
-			return makeConstExpr(s, typ, refs), true
-		}},
+		{10, makeConstScalar},
 	}
 
 	bools = []scalarExprWeight{
@@ -53,14 +51,20 @@ var (
 		{1, scalarNoContext(makeBinOp)},
 		{1, scalarNoContext(makeIn)},
 		{1, scalarNoContext(makeStringComparison)},
-		{1, func(s *Smither, ctx Context, typ *types.T, refs colRefs) (tree.TypedExpr, bool) {
-			return makeScalar(s, typ, refs), true
-		}},
+		{1, makeScalarBool},
 		{1, scalarNoContext(makeExists)},
 		{1, makeFunc},
 	}
 )
 
+func makeConstScalar(s *Smither, ctx Context, typ *types.T, refs colRefs) (tree.TypedExpr, bool) {
+	return makeConstExpr(s, typ, refs), true
+}
+
+func makeScalarBool(s *Smither, ctx Context, typ *types.T, refs colRefs) (tree.TypedExpr, bool) {
+	return makeScalar(s, typ, refs), true
+}
+
 // TODO(mjibson): remove this and correctly pass around the Context.
 func scalarNoContext(fn func(*Smither, *types.T, colRefs) (tree.TypedExpr, bool)) scalarExpr {
 	return func(s *Smither, ctx Context, t *types.T, refs colRefs) (tree.TypedExpr, bool) {
@@ -181,7 +181,9 @@ var Settings = map[string]SettingFunc{
 	"no-mutations+rand": randSetting(Parallel, DisableMutations()),
 	"no-ddl+rand":       randSetting(NoParallel, DisableDDLs()),
 	"ddl-nodrop":        randSetting(NoParallel, OnlyNoDropDDLs()),
+	"ddl-heavy":         randSetting(NoParallel, DDLHeavy()),
 	"multi-region":      randSetting(Parallel, MultiRegionDDLs()),
+	"new-types":         randSetting(Parallel, FavorNewTypes()),
 }
 
 var settingNames = func() []string {
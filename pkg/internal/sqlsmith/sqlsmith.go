@@ -97,6 +97,14 @@ type Smither struct {
 	lowProbWhereWithJoinTables bool
 	disableInsertSelect        bool
 
+	// corpus contains the statements set by SeedCorpus.
+	corpus []string
+
+	// favoredTypes contains the types set by FavorTypes.
+	favoredTypes []*types.T
+	// typeCoverage counts the types chosen so far, see TypeCoverage.
+	typeCoverage map[string]int
+
 	bulkSrv     *httptest.Server
 	bulkFiles   map[string][]byte
 	bulkBackups map[string]tree.BackupTargetList
@@ -118,6 +126,8 @@ func NewSmither(db *gosql.DB, rnd *rand.Rand, opts ...SmitherOption) (*Smither,
 		db:         db,
 		nameCounts: map[string]int{},
 
+		typeCoverage: map[string]int{},
+
 		stmtWeights:       allStatements,
 		alterWeights:      alters,
 		tableExprWeights:  allTableExprs,
@@ -163,6 +173,11 @@ var prettyCfg = func() tree.PrettyCfg {
 
 // Generate returns a random SQL string.
 func (s *Smither) Generate() string {
+	if len(s.corpus) > 0 && s.coin() {
+		if stmt, ok := s.mutateCorpusStmt(); ok {
+			return prettyCfg.Pretty(stmt)
+		}
+	}
 	i := 0
 	for {
 		stmt, ok := s.makeStmt()
@@ -308,6 +323,36 @@ var OnlyNoDropDDLs = simpleOption("only DDLs", func(s *Smither) {
 	)
 })
 
+// DDLHeavy causes the Smither to emit mostly schema changes (adding, altering
+// and dropping columns, indexes and constraints, changing primary keys, etc.),
+// interleaved with DML statements and transactions that exercise the tables
+// while the schema changes are in progress. Tables are never dropped, so that
+// the DML has something to operate on.
+var DDLHeavy = simpleOption("DDL heavy", func(s *Smither) {
+	s.stmtWeights = []statementWeight{
+		{20, makeAlter},
+		{5, makeInsert},
+		{5, makeUpdate},
+		{2, makeDelete},
+		{5, makeSelect},
+		{1, makeBegin},
+		{1, makeRollback},
+		{3, makeCommit},
+	}
+	s.alterWeights = append(append(append(append([]statementWeight{
+		{2, makeCreateTable},
+		{1, makeCreateSchema},
+	},
+		altersExistingTable...,
+	),
+		altersConstraints...,
+	),
+		altersTypeExistence...,
+	),
+		altersExistingTypes...,
+	)
+})
+
 // MultiRegionDDLs causes the Smither to enable multiregion features.
 var MultiRegionDDLs = simpleOption("include multiregion DDLs", func(s *Smither) {
 	s.alterWeights = append(s.alterWeights, alterMultiregion...)
@@ -430,6 +475,40 @@ var DisableInsertSelect = simpleOption("disable insert select", func(s *Smither)
 	s.disableInsertSelect = true
 })
 
+// FavorTypes causes the Smither to choose one of the given types about half of
+// the time it chooses a type for an expression, preferring the types it has
+// chosen the least so far (see TypeCoverage). Collated strings use a random
+// locale. This is useful to exercise types that don't otherwise come up often.
+func FavorTypes(typs ...*types.T) SmitherOption {
+	names := make([]string, len(typs))
+	for i, typ := range typs {
+		names[i] = typ.SQLString()
+	}
+	return option{
+		name: fmt.Sprintf("favor types (%s)", strings.Join(names, ", ")),
+		apply: func(s *Smither) {
+			s.favoredTypes = typs
+		},
+	}
+}
+
+// FavorNewTypes causes the Smither to favor (see FavorTypes) types that were
+// added more recently and are more likely to have type-specific bugs.
+var FavorNewTypes = func() SmitherOption {
+	return FavorTypes(
+		types.Jsonb,
+		types.MakeArray(types.Jsonb),
+		types.MakeCollatedString(types.String, "en"),
+		types.INet,
+		types.MakeArray(types.INet),
+		types.Box2D,
+		types.Geometry,
+		types.Geography,
+		types.TimeTZ,
+		types.VarBit,
+	)
+}
+
 // CompareMode causes the Smither to generate statements that have
 // deterministic output.
 var CompareMode = multiOption(
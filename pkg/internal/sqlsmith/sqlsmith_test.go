@@ -21,6 +21,9 @@ import (
 	_ "github.com/cockroachdb/cockroach/pkg/ccl"
 	"github.com/cockroachdb/cockroach/pkg/ccl/utilccl"
 	"github.com/cockroachdb/cockroach/pkg/sql/parser"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
 	"github.com/cockroachdb/cockroach/pkg/testutils/serverutils"
 	"github.com/cockroachdb/cockroach/pkg/testutils/sqlutils"
 	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
@@ -211,3 +214,247 @@ func TestGenerateParse(t *testing.T) {
 		}
 	}
 }
+
+func TestSetWeights(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	for _, tc := range []struct {
+		weights Weights
+		err     string
+	}{
+		{weights: Weights{Statements: map[string]int{"selec": 1}}, err: `unknown statement "selec"`},
+		{weights: Weights{Alters: map[string]int{"add_column": 0}}, err: `weight of alter "add_column" must be at least 1, found 0`},
+		{weights: Weights{TableExprs: map[string]int{}}, err: `no table expression weights specified`},
+		{weights: Weights{SelectStmts: map[string]int{"union": 1}}, err: `unknown select statement "union"`},
+		{weights: Weights{Scalars: map[string]int{"const": -1}}, err: `weight of scalar "const" must be at least 1, found -1`},
+		{weights: Weights{Bools: map[string]int{"scalar_subquery": 1}}, err: `unknown bool "scalar_subquery"`},
+	} {
+		if _, err := SetWeights(tc.weights); !testutils.IsError(err, tc.err) {
+			t.Errorf("expected error %q, found %v", tc.err, err)
+		}
+	}
+
+	// Only SELECT statements over VALUES should be generated.
+	opt, err := SetWeights(Weights{
+		Statements: map[string]int{"select": 1},
+		TableExprs: map[string]int{"values": 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rnd, _ := randutil.NewTestRand()
+	smither, err := NewSmither(nil /* db */, rnd, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer smither.Close()
+	for i := 0; i < 100; i++ {
+		stmt := smither.Generate()
+		parsed, err := parser.ParseOne(stmt)
+		if err != nil {
+			t.Fatalf("%s: %v", stmt, err)
+		}
+		if _, ok := parsed.AST.(*tree.Select); !ok {
+			t.Fatalf("expected a SELECT statement, found %s", stmt)
+		}
+	}
+
+	// The expression sections replace the corresponding productions.
+	opt, err = SetWeights(Weights{
+		Statements:  map[string]int{"select": 1},
+		SelectStmts: map[string]int{"select_clause": 1},
+		Scalars:     map[string]int{"col_ref": 2, "const": 1},
+		Bools:       map[string]int{"compare_op": 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	smither, err = NewSmither(nil /* db */, rnd, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer smither.Close()
+	if len(smither.selectStmtWeights) != 1 || len(smither.boolExprWeights) != 1 {
+		t.Fatalf("expected one select statement and one bool production, found %d and %d",
+			len(smither.selectStmtWeights), len(smither.boolExprWeights))
+	}
+	// Productions are sorted by name.
+	if w := smither.scalarExprWeights; len(w) != 2 || w[0].weight != 2 || w[1].weight != 1 {
+		t.Fatalf("unexpected scalar weights %v", w)
+	}
+	for i := 0; i < 100; i++ {
+		stmt := smither.Generate()
+		if _, err := parser.ParseOne(stmt); err != nil {
+			t.Fatalf("%s: %v", stmt, err)
+		}
+	}
+}
+
+func TestCorpus(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	dir, cleanup := testutils.TempDir(t)
+	defer cleanup()
+
+	const stmt = `SELECT 1:::INT8 + 2:::INT8`
+	// Exporting the same statement twice only stores it once.
+	for i := 0; i < 2; i++ {
+		if err := WriteCorpus(dir, stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	stmts, err := ReadCorpus(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stmts) != 1 || stmts[0] != stmt {
+		t.Fatalf("expected corpus [%s], found %v", stmt, stmts)
+	}
+
+	if _, err := SeedCorpus([]string{"SELEC 1"}); !testutils.IsError(err, `parsing corpus statement "SELEC 1"`) {
+		t.Fatalf("expected parse error, found %v", err)
+	}
+
+	opt, err := SeedCorpus(stmts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rnd, _ := randutil.NewTestRand()
+	smither, err := NewSmither(nil /* db */, rnd, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer smither.Close()
+	for i := 0; i < 100; i++ {
+		mutated, ok := smither.mutateCorpusStmt()
+		if !ok {
+			t.Fatalf("could not mutate %s", stmt)
+		}
+		// Only the constants are replaced.
+		sel, ok := mutated.(*tree.Select)
+		if !ok {
+			t.Fatalf("expected a SELECT statement, found %s", mutated)
+		}
+		expr := sel.Select.(*tree.SelectClause).Exprs[0].Expr
+		if _, ok := expr.(*tree.BinaryExpr); !ok {
+			t.Fatalf("expected a binary expression, found %s", expr)
+		}
+		if _, err := parser.ParseOne(prettyCfg.Pretty(mutated)); err != nil {
+			t.Fatalf("%s: %v", mutated, err)
+		}
+	}
+}
+
+func TestFavorTypes(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	rnd, _ := randutil.NewTestRand()
+	smither, err := NewSmither(nil /* db */, rnd, FavorTypes(types.INet))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer smither.Close()
+	for i := 0; i < 1000; i++ {
+		smither.GenerateExpr()
+	}
+	coverage := smither.TypeCoverage()
+	for typ, n := range coverage {
+		if typ != "inet" && n >= coverage["inet"] {
+			t.Errorf("expected inet to be the most common type, found %s: %d, inet: %d", typ, n, coverage["inet"])
+		}
+	}
+}
+
+// TestPickAnyType tests that pickAnyType resolves types.Any and
+// types.AnyArray to concrete types of the same shape, whether or not it
+// picks a favored type.
+func TestPickAnyType(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	rnd, _ := randutil.NewTestRand()
+	for _, opts := range [][]SmitherOption{
+		nil,
+		{FavorTypes(types.INet, types.MakeArray(types.INet))},
+	} {
+		smither, err := NewSmither(nil /* db */, rnd, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 100; i++ {
+			if typ := smither.pickAnyType(types.Any); typ.Family() == types.AnyFamily {
+				t.Fatalf("expected a concrete type, found %s", typ)
+			}
+			typ := smither.pickAnyType(types.AnyArray)
+			if typ.Family() != types.ArrayFamily || typ.ArrayContents().Family() == types.AnyFamily {
+				t.Fatalf("expected a concrete array type, found %s", typ)
+			}
+		}
+		smither.Close()
+	}
+}
+
+// TestDDLHeavy tests that the DDLHeavy option generates mostly schema changes,
+// including constraint changes, and never drops tables.
+func TestDDLHeavy(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	ctx := context.Background()
+	s, sqlDB, _ := serverutils.StartServer(t, base.TestServerArgs{})
+	defer s.Stopper().Stop(ctx)
+
+	db := sqlutils.MakeSQLRunner(sqlDB)
+	db.Exec(t, `CREATE TABLE t1 (a INT PRIMARY KEY, b INT UNIQUE, c STRING, CHECK (a > 0))`)
+	db.Exec(t, `CREATE TABLE t2 (x INT PRIMARY KEY, y INT REFERENCES t1 (b), z STRING)`)
+
+	rnd, seed := randutil.NewTestRand()
+	t.Log("seed:", seed)
+	smither, err := NewSmither(sqlDB, rnd, DDLHeavy())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer smither.Close()
+
+	const numStmts = 1000
+	var numAlters int
+	seen := map[string]bool{}
+	for i := 0; i < numStmts; i++ {
+		stmt := smither.Generate()
+		parsed, err := parser.ParseOne(stmt)
+		if err != nil {
+			t.Fatalf("%s: %v", stmt, err)
+		}
+		switch ast := parsed.AST.(type) {
+		case *tree.DropTable:
+			t.Fatalf("unexpected DROP TABLE: %s", stmt)
+		case *tree.AlterTable:
+			numAlters++
+			for _, cmd := range ast.Cmds {
+				switch cmd := cmd.(type) {
+				case *tree.AlterTableAddConstraint:
+					switch cmd.ConstraintDef.(type) {
+					case *tree.CheckConstraintTableDef:
+						seen["CHECK constraint"] = true
+					case *tree.UniqueConstraintTableDef:
+						seen["UNIQUE constraint"] = true
+					case *tree.ForeignKeyConstraintTableDef:
+						seen["foreign key"] = true
+					}
+				case *tree.AlterTableDropConstraint:
+					seen["DROP CONSTRAINT"] = true
+				}
+			}
+		case *tree.CreateIndex, *tree.DropIndex, *tree.RenameIndex, *tree.RenameTable,
+			*tree.CreateTable, *tree.CreateSchema, *tree.CreateType, *tree.AlterType:
+			numAlters++
+		}
+	}
+	// Schema changes make up about half of the statements.
+	if numAlters < numStmts/4 {
+		t.Errorf("expected mostly schema changes, found %d out of %d statements", numAlters, numStmts)
+	}
+	for _, kind := range []string{"CHECK constraint", "UNIQUE constraint", "foreign key", "DROP CONSTRAINT"} {
+		if !seen[kind] {
+			t.Errorf("expected a %s to be generated", kind)
+		}
+	}
+}
@@ -34,27 +34,36 @@ func (s *Smither) typeFromSQLTypeSyntax(typeStr string) (*types.T, error) {
 }
 
 // pickAnyType returns a concrete type if typ is types.Any or types.AnyArray,
-// otherwise typ.
+// otherwise typ. For types.AnyArray, the concrete type is an array type.
 func (s *Smither) pickAnyType(typ *types.T) *types.T {
 	switch typ.Family() {
 	case types.AnyFamily:
 		typ = s.randType()
 	case types.ArrayFamily:
 		if typ.ArrayContents().Family() == types.AnyFamily {
-			typ = randgen.RandArrayContentsType(s.rnd)
+			if favored := s.favoredType(isArrayType); favored != nil {
+				return favored
+			}
+			typ = types.MakeArray(randgen.RandArrayContentsType(s.rnd))
+			s.recordType(typ)
 		}
 	}
 	return typ
 }
 
 func (s *Smither) randScalarType() *types.T {
+	if favored := s.favoredType(isScalarFamily); favored != nil {
+		return favored
+	}
 	s.lock.RLock()
-	defer s.lock.RUnlock()
 	scalarTypes := types.Scalar
 	if s.types != nil {
 		scalarTypes = s.types.scalarTypes
 	}
-	return randgen.RandTypeFromSlice(s.rnd, scalarTypes)
+	typ := randgen.RandTypeFromSlice(s.rnd, scalarTypes)
+	s.lock.RUnlock()
+	s.recordType(typ)
+	return typ
 }
 
 // isScalarType returns true if t is a member of types.Scalar, or a user defined
@@ -75,13 +84,89 @@ func (s *Smither) isScalarType(t *types.T) bool {
 }
 
 func (s *Smither) randType() *types.T {
+	if favored := s.favoredType(func(*types.T) bool { return true }); favored != nil {
+		return favored
+	}
 	s.lock.RLock()
-	defer s.lock.RUnlock()
 	seedTypes := randgen.SeedTypes
 	if s.types != nil {
 		seedTypes = s.types.seedTypes
 	}
-	return randgen.RandTypeFromSlice(s.rnd, seedTypes)
+	typ := randgen.RandTypeFromSlice(s.rnd, seedTypes)
+	s.lock.RUnlock()
+	s.recordType(typ)
+	return typ
+}
+
+// favoredType returns, half of the time, the type set by FavorTypes that
+// satisfies ok and has been generated the least so far, which steers the
+// Smither towards the favored types that are still missing from its type
+// coverage. Otherwise, or if there is no such type, it returns nil.
+func (s *Smither) favoredType(ok func(*types.T) bool) *types.T {
+	if len(s.favoredTypes) == 0 || s.coin() {
+		return nil
+	}
+	s.lock.RLock()
+	var res *types.T
+	var min int
+	for _, typ := range s.favoredTypes {
+		if !ok(typ) {
+			continue
+		}
+		if n := s.typeCoverage[typeCoverageKey(typ)]; res == nil || n < min {
+			res, min = typ, n
+		}
+	}
+	s.lock.RUnlock()
+	if res == nil {
+		return nil
+	}
+	if res.Family() == types.CollatedStringFamily {
+		res = types.MakeCollatedString(types.String, *randgen.RandCollationLocale(s.rnd))
+	}
+	s.recordType(res)
+	return res
+}
+
+// recordType adds typ to the Smither's type coverage.
+func (s *Smither) recordType(typ *types.T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.typeCoverage[typeCoverageKey(typ)]++
+}
+
+// TypeCoverage returns the number of times each type was chosen by the
+// Smither so far, keyed by type name. Collated strings are counted together
+// regardless of their locale.
+func (s *Smither) TypeCoverage() map[string]int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	res := make(map[string]int, len(s.typeCoverage))
+	for k, v := range s.typeCoverage {
+		res[k] = v
+	}
+	return res
+}
+
+func typeCoverageKey(typ *types.T) string {
+	switch typ.Family() {
+	case types.ArrayFamily:
+		if typ.Oid() == oid.T_oidvector || typ.Oid() == oid.T_int2vector {
+			return typ.Name()
+		}
+		return typeCoverageKey(typ.ArrayContents()) + "[]"
+	case types.CollatedStringFamily:
+		return typ.Name() + " collate"
+	}
+	return typ.Name()
+}
+
+func isArrayType(typ *types.T) bool {
+	return typ.Family() == types.ArrayFamily
+}
+
+func isScalarFamily(typ *types.T) bool {
+	return typ.Family() != types.ArrayFamily && typ.Family() != types.TupleFamily
 }
 
 func (s *Smither) makeDesiredTypes() []*types.T {
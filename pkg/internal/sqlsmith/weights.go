@@ -0,0 +1,317 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package sqlsmith
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Weights configures the relative frequencies of the productions the Smither
+// chooses from. Each map is keyed by production name; a nil map leaves the
+// corresponding productions unchanged, while a non-nil map replaces them
+// entirely, so productions that are not listed are never generated.
+//
+// Weights can be decoded from JSON, for example:
+//
+//	{
+//	  "statements": {"select": 10, "alter": 5},
+//	  "alters": {"add_column": 5, "create_index": 5, "drop_column": 1},
+//	  "table_exprs": {"join": 10, "equi_join": 10, "merge_join": 10, "table": 1},
+//	  "select_stmts": {"select_clause": 5, "set_op": 1},
+//	  "scalars": {"col_ref": 10, "func": 10, "const": 1},
+//	  "bools": {"compare_op": 5, "and": 1, "or": 1}
+//	}
+type Weights struct {
+	Statements  map[string]int `json:"statements,omitempty"`
+	Alters      map[string]int `json:"alters,omitempty"`
+	TableExprs  map[string]int `json:"table_exprs,omitempty"`
+	SelectStmts map[string]int `json:"select_stmts,omitempty"`
+	// Scalars configures the expressions generated for values of any type,
+	// and Bools those generated for boolean conditions such as WHERE clauses.
+	Scalars map[string]int `json:"scalars,omitempty"`
+	Bools   map[string]int `json:"bools,omitempty"`
+}
+
+var statementsByName = map[string]statement{
+	"select":                makeSelect,
+	"insert":                makeInsert,
+	"update":                makeUpdate,
+	"delete":                makeDelete,
+	"alter":                 makeAlter,
+	"begin":                 makeBegin,
+	"commit":                makeCommit,
+	"rollback":              makeRollback,
+	"savepoint":             makeSavepoint,
+	"release_savepoint":     makeReleaseSavepoint,
+	"rollback_to_savepoint": makeRollbackToSavepoint,
+	"backup":                makeBackup,
+	"restore":               makeRestore,
+	"export":                makeExport,
+	"import":                makeImport,
+}
+
+var altersByName = map[string]statement{
+	"create_table":               makeCreateTable,
+	"create_schema":              makeCreateSchema,
+	"drop_table":                 makeDropTable,
+	"rename_table":               makeRenameTable,
+	"add_column":                 makeAddColumn,
+	"json_computed_column":       makeJSONComputedColumn,
+	"alter_primary_key":          makeAlterPrimaryKey,
+	"drop_column":                makeDropColumn,
+	"rename_column":              makeRenameColumn,
+	"alter_column_type":          makeAlterColumnType,
+	"create_index":               makeCreateIndex,
+	"drop_index":                 makeDropIndex,
+	"rename_index":               makeRenameIndex,
+	"add_check_constraint":       makeAddCheckConstraint,
+	"add_unique_constraint":      makeAddUniqueConstraint,
+	"add_foreign_key":            makeAddForeignKey,
+	"drop_constraint":            makeDropConstraint,
+	"create_type":                makeCreateType,
+	"alter_type_drop_value":      makeAlterTypeDropValue,
+	"alter_type_add_value":       makeAlterTypeAddValue,
+	"alter_type_rename_value":    makeAlterTypeRenameValue,
+	"alter_type_rename_type":     makeAlterTypeRenameType,
+	"alter_locality":             makeAlterLocality,
+	"alter_database_add_region":  makeAlterDatabaseAddRegion,
+	"alter_database_drop_region": makeAlterDatabaseDropRegion,
+	"alter_survival_goal":        makeAlterSurvivalGoal,
+	"alter_database_placement":   makeAlterDatabasePlacement,
+}
+
+var tableExprsByName = map[string]tableExpr{
+	"table":            makeSchemaTable,
+	"join":             makeJoinExpr,
+	"equi_join":        makeEquiJoinExpr,
+	"merge_join":       makeMergeJoinExpr,
+	"values":           makeValuesTable,
+	"select":           makeSelectTable,
+	"insert_returning": makeInsertReturning,
+	"delete_returning": makeDeleteReturning,
+	"update_returning": makeUpdateReturning,
+}
+
+var selectStmtsByName = map[string]selectStatement{
+	"values":        makeValuesSelect,
+	"set_op":        makeSetOp,
+	"select_clause": makeSelectClause,
+}
+
+var scalarsByName = map[string]scalarExpr{
+	"and":               scalarNoContext(makeAnd),
+	"or":                scalarNoContext(makeOr),
+	"not":               scalarNoContext(makeNot),
+	"case":              scalarNoContext(makeCaseExpr),
+	"coalesce":          scalarNoContext(makeCoalesceExpr),
+	"col_ref":           scalarNoContext(makeColRef),
+	"bin_op":            scalarNoContext(makeBinOp),
+	"scalar_subquery":   scalarNoContext(makeScalarSubquery),
+	"exists":            scalarNoContext(makeExists),
+	"in":                scalarNoContext(makeIn),
+	"string_comparison": scalarNoContext(makeStringComparison),
+	"func":              makeFunc,
+	"const":             makeConstScalar,
+}
+
+var boolsByName = map[string]scalarExpr{
+	"col_ref":           scalarNoContext(makeColRef),
+	"and":               scalarNoContext(makeAnd),
+	"or":                scalarNoContext(makeOr),
+	"not":               scalarNoContext(makeNot),
+	"compare_op":        scalarNoContext(makeCompareOp),
+	"bin_op":            scalarNoContext(makeBinOp),
+	"in":                scalarNoContext(makeIn),
+	"string_comparison": scalarNoContext(makeStringComparison),
+	"scalar":            makeScalarBool,
+	"exists":            scalarNoContext(makeExists),
+	"func":              makeFunc,
+}
+
+// SetWeights returns a SmitherOption that overrides the weights of the
+// productions listed in w. An error is returned if w refers to an unknown
+// production or contains a weight less than 1, or if one of its maps is
+// empty.
+func SetWeights(w Weights) (SmitherOption, error) {
+	stmts, err := makeStatementWeights("statement", w.Statements, statementsByName)
+	if err != nil {
+		return nil, err
+	}
+	alters, err := makeStatementWeights("alter", w.Alters, altersByName)
+	if err != nil {
+		return nil, err
+	}
+	var tableExprs []tableExprWeight
+	names, err := checkWeights("table expression", w.TableExprs, func(name string) bool {
+		_, ok := tableExprsByName[name]
+		return ok
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		tableExprs = append(tableExprs, tableExprWeight{w.TableExprs[name], tableExprsByName[name]})
+	}
+	var selectStmts []selectStatementWeight
+	names, err = checkWeights("select statement", w.SelectStmts, func(name string) bool {
+		_, ok := selectStmtsByName[name]
+		return ok
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		selectStmts = append(selectStmts, selectStatementWeight{
+			w.SelectStmts[name], selectStmtsByName[name],
+		})
+	}
+	scalars, err := makeScalarExprWeights("scalar", w.Scalars, scalarsByName)
+	if err != nil {
+		return nil, err
+	}
+	bools, err := makeScalarExprWeights("bool", w.Bools, boolsByName)
+	if err != nil {
+		return nil, err
+	}
+	return option{
+		name: fmt.Sprintf("set weights %s", w),
+		apply: func(s *Smither) {
+			if stmts != nil {
+				s.stmtWeights = stmts
+			}
+			if alters != nil {
+				s.alterWeights = alters
+			}
+			if tableExprs != nil {
+				s.tableExprWeights = tableExprs
+			}
+			if selectStmts != nil {
+				s.selectStmtWeights = selectStmts
+			}
+			if scalars != nil {
+				s.scalarExprWeights = scalars
+			}
+			if bools != nil {
+				s.boolExprWeights = bools
+			}
+		},
+	}, nil
+}
+
+func makeStatementWeights(
+	kind string, weights map[string]int, byName map[string]statement,
+) ([]statementWeight, error) {
+	names, err := checkWeights(kind, weights, func(name string) bool {
+		_, ok := byName[name]
+		return ok
+	})
+	if err != nil || names == nil {
+		return nil, err
+	}
+	res := make([]statementWeight, 0, len(names))
+	for _, name := range names {
+		res = append(res, statementWeight{weights[name], byName[name]})
+	}
+	return res, nil
+}
+
+func makeScalarExprWeights(
+	kind string, weights map[string]int, byName map[string]scalarExpr,
+) ([]scalarExprWeight, error) {
+	names, err := checkWeights(kind, weights, func(name string) bool {
+		_, ok := byName[name]
+		return ok
+	})
+	if err != nil || names == nil {
+		return nil, err
+	}
+	res := make([]scalarExprWeight, 0, len(names))
+	for _, name := range names {
+		res = append(res, scalarExprWeight{weights[name], byName[name]})
+	}
+	return res, nil
+}
+
+// checkWeights validates the weights of one section of Weights and returns
+// the production names in sorted order, or nil if the section is not set.
+func checkWeights(
+	kind string, weights map[string]int, known func(name string) bool,
+) ([]string, error) {
+	if weights == nil {
+		return nil, nil
+	}
+	if len(weights) == 0 {
+		return nil, errors.Errorf("no %s weights specified", kind)
+	}
+	names := sortedKeys(weights)
+	for _, name := range names {
+		if !known(name) {
+			return nil, errors.Errorf("unknown %s %q", kind, name)
+		}
+		if err := checkWeight(kind, name, weights[name]); err != nil {
+			return nil, err
+		}
+	}
+	return names, nil
+}
+
+func checkWeight(kind, name string, weight int) error {
+	if weight < 1 {
+		return errors.Errorf("weight of %s %q must be at least 1, found %d", kind, name, weight)
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// String implements the fmt.Stringer interface.
+func (w Weights) String() string {
+	var sb strings.Builder
+	delim := ""
+	for _, section := range []struct {
+		name    string
+		weights map[string]int
+	}{
+		{"statements", w.Statements},
+		{"alters", w.Alters},
+		{"table_exprs", w.TableExprs},
+		{"select_stmts", w.SelectStmts},
+		{"scalars", w.Scalars},
+		{"bools", w.Bools},
+	} {
+		if section.weights == nil {
+			continue
+		}
+		sb.WriteString(delim)
+		delim = " "
+		sb.WriteString(section.name)
+		sb.WriteString("(")
+		for i, name := range sortedKeys(section.weights) {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			fmt.Fprintf(&sb, "%s: %d", name, section.weights[name])
+		}
+		sb.WriteString(")")
+	}
+	return sb.String()
+}
@@ -1363,8 +1363,6 @@ func (s *adminServer) usersHelper(
 	return &resp, nil
 }
 
-var eventSetClusterSettingName = logpb.GetEventTypeName(&eventpb.SetClusterSetting{})
-
 // combineAllErrors combines all passed-in errors into a single object.
 func combineAllErrors(errs []error) error {
 	var combinedErrors error
@@ -1470,7 +1468,7 @@ func (s *adminServer) eventsHelper(
 		if err := scanner.ScanIndex(row, 3, &event.Info); err != nil {
 			return nil, err
 		}
-		if event.EventType == eventSetClusterSettingName {
+		if eventpb.EventType(event.EventType) == eventpb.EventTypeSetClusterSetting {
 			if redactEvents {
 				event.Info = redactSettingsChange(event.Info)
 			}
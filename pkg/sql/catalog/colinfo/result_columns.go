@@ -11,6 +11,8 @@
 package colinfo
 
 import (
+	"fmt"
+
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
@@ -75,8 +77,16 @@ func (r ResultColumn) GetTypeModifier() int32 {
 // TypesEqual returns whether the length and types of r matches other. If
 // a type in other is NULL, it is considered equal.
 func (r ResultColumns) TypesEqual(other ResultColumns) bool {
+	ok, _ := r.TypesEqualWithReason(other)
+	return ok
+}
+
+// TypesEqualWithReason is the same as TypesEqual, but if the types don't
+// match, it also returns a human-readable description of the first difference
+// (including the index of the mismatching column, if any).
+func (r ResultColumns) TypesEqualWithReason(other ResultColumns) (ok bool, reason string) {
 	if len(r) != len(other) {
-		return false
+		return false, fmt.Sprintf("expected %d columns, found %d", len(r), len(other))
 	}
 	for i, c := range r {
 		// NULLs are considered equal because some types of queries (SELECT CASE,
@@ -86,10 +96,13 @@ func (r ResultColumns) TypesEqual(other ResultColumns) bool {
 			continue
 		}
 		if !c.Typ.Equivalent(other[i].Typ) {
-			return false
+			return false, fmt.Sprintf(
+				"column %d (%q): expected type %s, found %s",
+				i, c.Name, c.Typ.SQLString(), other[i].Typ.SQLString(),
+			)
 		}
 	}
-	return true
+	return true, ""
 }
 
 // NodeFormatter returns a tree.NodeFormatter that, when formatted,
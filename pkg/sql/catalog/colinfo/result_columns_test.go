@@ -67,3 +67,37 @@ func TestResultColumnsTypesEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestResultColumnsTypesEqualWithReason(t *testing.T) {
+	tests := []struct {
+		r, o   ResultColumns
+		reason string
+	}{
+		{
+			r:      ResultColumns{{Name: "a", Typ: types.Int}, {Name: "b", Typ: types.String}},
+			o:      ResultColumns{{Name: "a", Typ: types.Int}, {Name: "b", Typ: types.String}},
+			reason: "",
+		},
+		{
+			r:      ResultColumns{{Name: "a", Typ: types.Int}, {Name: "b", Typ: types.String}},
+			o:      ResultColumns{{Name: "a", Typ: types.Int}, {Name: "b", Typ: types.Bool}},
+			reason: `column 1 ("b"): expected type STRING, found BOOL`,
+		},
+		{
+			r:      ResultColumns{{Name: "a", Typ: types.Int}, {Name: "b", Typ: types.String}},
+			o:      ResultColumns{{Name: "a", Typ: types.Int}},
+			reason: "expected 2 columns, found 1",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%v-%v", tc.r, tc.o), func(t *testing.T) {
+			ok, reason := tc.r.TypesEqualWithReason(tc.o)
+			if ok != (tc.reason == "") {
+				t.Fatalf("expected equal=%t, got %t", tc.reason == "", ok)
+			}
+			if reason != tc.reason {
+				t.Fatalf("expected reason %q, got %q", tc.reason, reason)
+			}
+		})
+	}
+}
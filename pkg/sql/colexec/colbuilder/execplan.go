@@ -1868,6 +1868,12 @@ func examineLikeOp(op treecmp.ComparisonOperator) (negate bool, caseInsensitive
 	return negate, caseInsensitive
 }
 
+func examineRegexpOp(op treecmp.ComparisonOperator) (negate bool, caseInsensitive bool) {
+	negate = op.Symbol == treecmp.NotRegMatch || op.Symbol == treecmp.NotRegIMatch
+	caseInsensitive = op.Symbol == treecmp.RegIMatch || op.Symbol == treecmp.NotRegIMatch
+	return negate, caseInsensitive
+}
+
 func planSelectionOperators(
 	ctx context.Context,
 	evalCtx *eval.Context,
@@ -1921,6 +1927,27 @@ func planSelectionOperators(
 					evalCtx, leftOp, leftIdx, string(tree.MustBeDString(constArg)),
 					negate, caseInsensitive,
 				)
+			case treecmp.RegMatch, treecmp.NotRegMatch, treecmp.RegIMatch, treecmp.NotRegIMatch:
+				if lTyp.Family() != types.StringFamily || constArg == tree.DNull {
+					// RegMatch is also defined on box2d values, and NULL
+					// patterns need the default NULL handling.
+					break
+				}
+				negate, caseInsensitive := examineRegexpOp(cmpOp)
+				op, err = colexecsel.GetRegexpOperator(
+					evalCtx, leftOp, leftIdx, string(tree.MustBeDString(constArg)),
+					negate, caseInsensitive,
+				)
+			case treecmp.Contains, treecmp.ContainedBy:
+				jsonArg, ok := constArg.(*tree.DJSON)
+				if lTyp.Family() != types.JsonFamily || !ok {
+					// Containment is also defined on arrays, and NULL
+					// arguments need the default NULL handling.
+					break
+				}
+				op = colexecsel.GetJSONContainsOperator(
+					leftOp, leftIdx, jsonArg.JSON, cmpOp.Symbol == treecmp.ContainedBy,
+				)
 			case treecmp.In, treecmp.NotIn:
 				negate := cmpOp.Symbol == treecmp.NotIn
 				datumTuple, ok := tree.AsDTuple(constArg)
@@ -2518,6 +2545,15 @@ func planProjectionExpr(
 						allocator, evalCtx, input, leftIdx, resultIdx,
 						string(tree.MustBeDString(rConstArg)), negate, caseInsensitive,
 					)
+				case treecmp.Contains, treecmp.ContainedBy:
+					jsonArg, ok := rConstArg.(*tree.DJSON)
+					if typs[leftIdx].Family() != types.JsonFamily || !ok {
+						break
+					}
+					op = colexecprojconst.GetJSONContainsProjectionOperator(
+						allocator, input, leftIdx, resultIdx, jsonArg.JSON,
+						cmpProjOp.Symbol == treecmp.ContainedBy,
+					)
 				case treecmp.In, treecmp.NotIn:
 					negate := cmpProjOp.Symbol == treecmp.NotIn
 					datumTuple, ok := tree.AsDTuple(rConstArg)
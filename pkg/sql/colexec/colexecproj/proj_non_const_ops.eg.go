@@ -110,7 +110,7 @@ func (p projBitandInt16Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -194,7 +194,7 @@ func (p projBitandInt16Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -278,7 +278,7 @@ func (p projBitandInt16Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -362,7 +362,7 @@ func (p projBitandInt32Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -446,7 +446,7 @@ func (p projBitandInt32Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -530,7 +530,7 @@ func (p projBitandInt32Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -614,7 +614,7 @@ func (p projBitandInt64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -698,7 +698,7 @@ func (p projBitandInt64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -782,7 +782,7 @@ func (p projBitandInt64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -881,7 +881,7 @@ func (p projBitandDatumDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -977,7 +977,7 @@ func (p projBitorInt16Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1061,7 +1061,7 @@ func (p projBitorInt16Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1145,7 +1145,7 @@ func (p projBitorInt16Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1229,7 +1229,7 @@ func (p projBitorInt32Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1313,7 +1313,7 @@ func (p projBitorInt32Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1397,7 +1397,7 @@ func (p projBitorInt32Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1481,7 +1481,7 @@ func (p projBitorInt64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1565,7 +1565,7 @@ func (p projBitorInt64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1649,7 +1649,7 @@ func (p projBitorInt64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1748,7 +1748,7 @@ func (p projBitorDatumDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1844,7 +1844,7 @@ func (p projBitxorInt16Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1928,7 +1928,7 @@ func (p projBitxorInt16Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2012,7 +2012,7 @@ func (p projBitxorInt16Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2096,7 +2096,7 @@ func (p projBitxorInt32Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2180,7 +2180,7 @@ func (p projBitxorInt32Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2264,7 +2264,7 @@ func (p projBitxorInt32Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2348,7 +2348,7 @@ func (p projBitxorInt64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2432,7 +2432,7 @@ func (p projBitxorInt64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2516,7 +2516,7 @@ func (p projBitxorInt64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2615,7 +2615,7 @@ func (p projBitxorDatumDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2725,7 +2725,7 @@ func (p projPlusDecimalInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2837,7 +2837,7 @@ func (p projPlusDecimalInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2949,7 +2949,7 @@ func (p projPlusDecimalInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3059,7 +3059,7 @@ func (p projPlusDecimalDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3167,7 +3167,7 @@ func (p projPlusInt16Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3275,7 +3275,7 @@ func (p projPlusInt16Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3383,7 +3383,7 @@ func (p projPlusInt16Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3495,7 +3495,7 @@ func (p projPlusInt16DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3618,7 +3618,7 @@ func (p projPlusInt16DatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3735,7 +3735,7 @@ func (p projPlusInt32Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3843,7 +3843,7 @@ func (p projPlusInt32Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3951,7 +3951,7 @@ func (p projPlusInt32Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4063,7 +4063,7 @@ func (p projPlusInt32DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4186,7 +4186,7 @@ func (p projPlusInt32DatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4303,7 +4303,7 @@ func (p projPlusInt64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4411,7 +4411,7 @@ func (p projPlusInt64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4519,7 +4519,7 @@ func (p projPlusInt64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4631,7 +4631,7 @@ func (p projPlusInt64DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4754,7 +4754,7 @@ func (p projPlusInt64DatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4865,7 +4865,7 @@ func (p projPlusFloat64Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4961,7 +4961,7 @@ func (p projPlusTimestampIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5057,7 +5057,7 @@ func (p projPlusIntervalTimestampOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5143,7 +5143,7 @@ func (p projPlusIntervalIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5246,7 +5246,7 @@ func (p projPlusIntervalDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5374,7 +5374,7 @@ func (p projPlusDatumIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5502,7 +5502,7 @@ func (p projPlusDatumInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5630,7 +5630,7 @@ func (p projPlusDatumInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5758,7 +5758,7 @@ func (p projPlusDatumInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5877,7 +5877,7 @@ func (p projMinusDecimalInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5989,7 +5989,7 @@ func (p projMinusDecimalInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6101,7 +6101,7 @@ func (p projMinusDecimalInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6211,7 +6211,7 @@ func (p projMinusDecimalDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6319,7 +6319,7 @@ func (p projMinusInt16Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6427,7 +6427,7 @@ func (p projMinusInt16Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6535,7 +6535,7 @@ func (p projMinusInt16Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6647,7 +6647,7 @@ func (p projMinusInt16DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6770,7 +6770,7 @@ func (p projMinusInt16DatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6887,7 +6887,7 @@ func (p projMinusInt32Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6995,7 +6995,7 @@ func (p projMinusInt32Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7103,7 +7103,7 @@ func (p projMinusInt32Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7215,7 +7215,7 @@ func (p projMinusInt32DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7338,7 +7338,7 @@ func (p projMinusInt32DatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7455,7 +7455,7 @@ func (p projMinusInt64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7563,7 +7563,7 @@ func (p projMinusInt64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7671,7 +7671,7 @@ func (p projMinusInt64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7783,7 +7783,7 @@ func (p projMinusInt64DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7906,7 +7906,7 @@ func (p projMinusInt64DatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8017,7 +8017,7 @@ func (p projMinusFloat64Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8109,7 +8109,7 @@ func (p projMinusTimestampTimestampOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8201,7 +8201,7 @@ func (p projMinusTimestampIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8287,7 +8287,7 @@ func (p projMinusIntervalIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8390,7 +8390,7 @@ func (p projMinusIntervalDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8507,7 +8507,7 @@ func (p projMinusJSONBytesOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8608,7 +8608,7 @@ func (p projMinusJSONInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8702,7 +8702,7 @@ func (p projMinusJSONInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8796,7 +8796,7 @@ func (p projMinusJSONInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8900,7 +8900,7 @@ func (p projMinusDatumDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9019,7 +9019,7 @@ func (p projMinusDatumIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9146,7 +9146,7 @@ func (p projMinusDatumBytesOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9273,7 +9273,7 @@ func (p projMinusDatumInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9401,7 +9401,7 @@ func (p projMinusDatumInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9529,7 +9529,7 @@ func (p projMinusDatumInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9648,7 +9648,7 @@ func (p projMultDecimalInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9760,7 +9760,7 @@ func (p projMultDecimalInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9872,7 +9872,7 @@ func (p projMultDecimalInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9982,7 +9982,7 @@ func (p projMultDecimalDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10084,7 +10084,7 @@ func (p projMultDecimalIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10202,7 +10202,7 @@ func (p projMultInt16Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10342,7 +10342,7 @@ func (p projMultInt16Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10482,7 +10482,7 @@ func (p projMultInt16Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10610,7 +10610,7 @@ func (p projMultInt16DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10706,7 +10706,7 @@ func (p projMultInt16IntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10814,7 +10814,7 @@ func (p projMultInt32Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10954,7 +10954,7 @@ func (p projMultInt32Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11094,7 +11094,7 @@ func (p projMultInt32Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11222,7 +11222,7 @@ func (p projMultInt32DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11318,7 +11318,7 @@ func (p projMultInt32IntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11426,7 +11426,7 @@ func (p projMultInt64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11566,7 +11566,7 @@ func (p projMultInt64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11706,7 +11706,7 @@ func (p projMultInt64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11834,7 +11834,7 @@ func (p projMultInt64DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11930,7 +11930,7 @@ func (p projMultInt64IntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12016,7 +12016,7 @@ func (p projMultFloat64Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12102,7 +12102,7 @@ func (p projMultFloat64IntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12178,7 +12178,7 @@ func (p projMultIntervalInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12254,7 +12254,7 @@ func (p projMultIntervalInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12330,7 +12330,7 @@ func (p projMultIntervalInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12406,7 +12406,7 @@ func (p projMultIntervalFloat64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12492,7 +12492,7 @@ func (p projMultIntervalDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12604,7 +12604,7 @@ func (p projDivDecimalInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12732,7 +12732,7 @@ func (p projDivDecimalInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12860,7 +12860,7 @@ func (p projDivDecimalInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12986,7 +12986,7 @@ func (p projDivDecimalDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13110,7 +13110,7 @@ func (p projDivInt16Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13234,7 +13234,7 @@ func (p projDivInt16Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13358,7 +13358,7 @@ func (p projDivInt16Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13486,7 +13486,7 @@ func (p projDivInt16DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13614,7 +13614,7 @@ func (p projDivInt32Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13738,7 +13738,7 @@ func (p projDivInt32Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13862,7 +13862,7 @@ func (p projDivInt32Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13990,7 +13990,7 @@ func (p projDivInt32DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14118,7 +14118,7 @@ func (p projDivInt64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14242,7 +14242,7 @@ func (p projDivInt64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14366,7 +14366,7 @@ func (p projDivInt64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14494,7 +14494,7 @@ func (p projDivInt64DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14616,7 +14616,7 @@ func (p projDivFloat64Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14718,7 +14718,7 @@ func (p projDivIntervalInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14810,7 +14810,7 @@ func (p projDivIntervalInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14902,7 +14902,7 @@ func (p projDivIntervalInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14994,7 +14994,7 @@ func (p projDivIntervalFloat64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15104,7 +15104,7 @@ func (p projFloorDivDecimalInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15232,7 +15232,7 @@ func (p projFloorDivDecimalInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15360,7 +15360,7 @@ func (p projFloorDivDecimalInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15486,7 +15486,7 @@ func (p projFloorDivDecimalDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15600,7 +15600,7 @@ func (p projFloorDivInt16Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15704,7 +15704,7 @@ func (p projFloorDivInt16Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15808,7 +15808,7 @@ func (p projFloorDivInt16Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15926,7 +15926,7 @@ func (p projFloorDivInt16DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16044,7 +16044,7 @@ func (p projFloorDivInt32Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16148,7 +16148,7 @@ func (p projFloorDivInt32Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16252,7 +16252,7 @@ func (p projFloorDivInt32Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16370,7 +16370,7 @@ func (p projFloorDivInt32DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16488,7 +16488,7 @@ func (p projFloorDivInt64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16592,7 +16592,7 @@ func (p projFloorDivInt64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16696,7 +16696,7 @@ func (p projFloorDivInt64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16814,7 +16814,7 @@ func (p projFloorDivInt64DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16936,7 +16936,7 @@ func (p projFloorDivFloat64Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17056,7 +17056,7 @@ func (p projModDecimalInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17184,7 +17184,7 @@ func (p projModDecimalInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17312,7 +17312,7 @@ func (p projModDecimalInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17438,7 +17438,7 @@ func (p projModDecimalDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17552,7 +17552,7 @@ func (p projModInt16Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17656,7 +17656,7 @@ func (p projModInt16Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17760,7 +17760,7 @@ func (p projModInt16Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17878,7 +17878,7 @@ func (p projModInt16DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17996,7 +17996,7 @@ func (p projModInt32Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18100,7 +18100,7 @@ func (p projModInt32Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18204,7 +18204,7 @@ func (p projModInt32Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18322,7 +18322,7 @@ func (p projModInt32DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18440,7 +18440,7 @@ func (p projModInt64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18544,7 +18544,7 @@ func (p projModInt64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18648,7 +18648,7 @@ func (p projModInt64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18766,7 +18766,7 @@ func (p projModInt64DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18888,7 +18888,7 @@ func (p projModFloat64Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19000,7 +19000,7 @@ func (p projPowDecimalInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19112,7 +19112,7 @@ func (p projPowDecimalInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19224,7 +19224,7 @@ func (p projPowDecimalInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19334,7 +19334,7 @@ func (p projPowDecimalDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19454,7 +19454,7 @@ func (p projPowInt16Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19586,7 +19586,7 @@ func (p projPowInt16Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19718,7 +19718,7 @@ func (p projPowInt16Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19842,7 +19842,7 @@ func (p projPowInt16DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19966,7 +19966,7 @@ func (p projPowInt32Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20098,7 +20098,7 @@ func (p projPowInt32Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20230,7 +20230,7 @@ func (p projPowInt32Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20354,7 +20354,7 @@ func (p projPowInt32DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20478,7 +20478,7 @@ func (p projPowInt64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20610,7 +20610,7 @@ func (p projPowInt64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20742,7 +20742,7 @@ func (p projPowInt64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20866,7 +20866,7 @@ func (p projPowInt64DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20972,7 +20972,7 @@ func (p projPowFloat64Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21070,7 +21070,7 @@ func (p projConcatBytesBytesOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21168,7 +21168,7 @@ func (p projConcatJSONJSONOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21273,7 +21273,7 @@ func (p projConcatDatumDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21381,7 +21381,7 @@ func (p projLShiftInt16Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21489,7 +21489,7 @@ func (p projLShiftInt16Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21597,7 +21597,7 @@ func (p projLShiftInt16Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21705,7 +21705,7 @@ func (p projLShiftInt32Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21813,7 +21813,7 @@ func (p projLShiftInt32Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21921,7 +21921,7 @@ func (p projLShiftInt32Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22029,7 +22029,7 @@ func (p projLShiftInt64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22137,7 +22137,7 @@ func (p projLShiftInt64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22245,7 +22245,7 @@ func (p projLShiftInt64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22364,7 +22364,7 @@ func (p projLShiftDatumInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22492,7 +22492,7 @@ func (p projLShiftDatumInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22620,7 +22620,7 @@ func (p projLShiftDatumInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22737,7 +22737,7 @@ func (p projRShiftInt16Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22845,7 +22845,7 @@ func (p projRShiftInt16Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22953,7 +22953,7 @@ func (p projRShiftInt16Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -23061,7 +23061,7 @@ func (p projRShiftInt32Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -23169,7 +23169,7 @@ func (p projRShiftInt32Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -23277,7 +23277,7 @@ func (p projRShiftInt32Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -23385,7 +23385,7 @@ func (p projRShiftInt64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -23493,7 +23493,7 @@ func (p projRShiftInt64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -23601,7 +23601,7 @@ func (p projRShiftInt64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -23720,7 +23720,7 @@ func (p projRShiftDatumInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -23848,7 +23848,7 @@ func (p projRShiftDatumInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -23976,7 +23976,7 @@ func (p projRShiftDatumInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -24099,7 +24099,7 @@ func (p projJSONFetchValJSONBytesOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -24214,7 +24214,7 @@ func (p projJSONFetchValJSONInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -24324,7 +24324,7 @@ func (p projJSONFetchValJSONInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -24434,7 +24434,7 @@ func (p projJSONFetchValJSONInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -24567,7 +24567,7 @@ func (p projJSONFetchTextJSONBytesOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -24718,7 +24718,7 @@ func (p projJSONFetchTextJSONInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -24864,7 +24864,7 @@ func (p projJSONFetchTextJSONInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -25010,7 +25010,7 @@ func (p projJSONFetchTextJSONInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -25137,7 +25137,7 @@ func (p projJSONFetchValPathJSONDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -25265,7 +25265,7 @@ func (p projJSONFetchTextPathJSONDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -25405,7 +25405,7 @@ func (p projEQBoolBoolOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -25519,7 +25519,7 @@ func (p projEQBytesBytesOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -25629,7 +25629,7 @@ func (p projEQDecimalInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -25753,7 +25753,7 @@ func (p projEQDecimalInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -25877,7 +25877,7 @@ func (p projEQDecimalInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -26005,7 +26005,7 @@ func (p projEQDecimalFloat64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -26121,7 +26121,7 @@ func (p projEQDecimalDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -26243,7 +26243,7 @@ func (p projEQInt16Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -26387,7 +26387,7 @@ func (p projEQInt16Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -26531,7 +26531,7 @@ func (p projEQInt16Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -26691,7 +26691,7 @@ func (p projEQInt16Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -26841,7 +26841,7 @@ func (p projEQInt16DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -26975,7 +26975,7 @@ func (p projEQInt32Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -27119,7 +27119,7 @@ func (p projEQInt32Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -27263,7 +27263,7 @@ func (p projEQInt32Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -27423,7 +27423,7 @@ func (p projEQInt32Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -27573,7 +27573,7 @@ func (p projEQInt32DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -27707,7 +27707,7 @@ func (p projEQInt64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -27851,7 +27851,7 @@ func (p projEQInt64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -27995,7 +27995,7 @@ func (p projEQInt64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -28155,7 +28155,7 @@ func (p projEQInt64Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -28305,7 +28305,7 @@ func (p projEQInt64DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -28455,7 +28455,7 @@ func (p projEQFloat64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -28631,7 +28631,7 @@ func (p projEQFloat64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -28807,7 +28807,7 @@ func (p projEQFloat64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -28983,7 +28983,7 @@ func (p projEQFloat64Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -29137,7 +29137,7 @@ func (p projEQFloat64DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -29267,7 +29267,7 @@ func (p projEQTimestampTimestampOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -29381,7 +29381,7 @@ func (p projEQIntervalIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -29491,7 +29491,7 @@ func (p projEQJSONJSONOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -29604,7 +29604,7 @@ func (p projEQDatumDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -29722,7 +29722,7 @@ func (p projNEBoolBoolOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -29836,7 +29836,7 @@ func (p projNEBytesBytesOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -29946,7 +29946,7 @@ func (p projNEDecimalInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -30070,7 +30070,7 @@ func (p projNEDecimalInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -30194,7 +30194,7 @@ func (p projNEDecimalInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -30322,7 +30322,7 @@ func (p projNEDecimalFloat64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -30438,7 +30438,7 @@ func (p projNEDecimalDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -30560,7 +30560,7 @@ func (p projNEInt16Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -30704,7 +30704,7 @@ func (p projNEInt16Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -30848,7 +30848,7 @@ func (p projNEInt16Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -31008,7 +31008,7 @@ func (p projNEInt16Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -31158,7 +31158,7 @@ func (p projNEInt16DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -31292,7 +31292,7 @@ func (p projNEInt32Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -31436,7 +31436,7 @@ func (p projNEInt32Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -31580,7 +31580,7 @@ func (p projNEInt32Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -31740,7 +31740,7 @@ func (p projNEInt32Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -31890,7 +31890,7 @@ func (p projNEInt32DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -32024,7 +32024,7 @@ func (p projNEInt64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -32168,7 +32168,7 @@ func (p projNEInt64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -32312,7 +32312,7 @@ func (p projNEInt64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -32472,7 +32472,7 @@ func (p projNEInt64Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -32622,7 +32622,7 @@ func (p projNEInt64DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -32772,7 +32772,7 @@ func (p projNEFloat64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -32948,7 +32948,7 @@ func (p projNEFloat64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -33124,7 +33124,7 @@ func (p projNEFloat64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -33300,7 +33300,7 @@ func (p projNEFloat64Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -33454,7 +33454,7 @@ func (p projNEFloat64DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -33584,7 +33584,7 @@ func (p projNETimestampTimestampOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -33698,7 +33698,7 @@ func (p projNEIntervalIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -33808,7 +33808,7 @@ func (p projNEJSONJSONOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -33921,7 +33921,7 @@ func (p projNEDatumDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -34039,7 +34039,7 @@ func (p projLTBoolBoolOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -34153,7 +34153,7 @@ func (p projLTBytesBytesOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -34263,7 +34263,7 @@ func (p projLTDecimalInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -34387,7 +34387,7 @@ func (p projLTDecimalInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -34511,7 +34511,7 @@ func (p projLTDecimalInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -34639,7 +34639,7 @@ func (p projLTDecimalFloat64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -34755,7 +34755,7 @@ func (p projLTDecimalDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -34877,7 +34877,7 @@ func (p projLTInt16Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -35021,7 +35021,7 @@ func (p projLTInt16Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -35165,7 +35165,7 @@ func (p projLTInt16Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -35325,7 +35325,7 @@ func (p projLTInt16Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -35475,7 +35475,7 @@ func (p projLTInt16DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -35609,7 +35609,7 @@ func (p projLTInt32Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -35753,7 +35753,7 @@ func (p projLTInt32Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -35897,7 +35897,7 @@ func (p projLTInt32Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -36057,7 +36057,7 @@ func (p projLTInt32Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -36207,7 +36207,7 @@ func (p projLTInt32DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -36341,7 +36341,7 @@ func (p projLTInt64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -36485,7 +36485,7 @@ func (p projLTInt64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -36629,7 +36629,7 @@ func (p projLTInt64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -36789,7 +36789,7 @@ func (p projLTInt64Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -36939,7 +36939,7 @@ func (p projLTInt64DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -37089,7 +37089,7 @@ func (p projLTFloat64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -37265,7 +37265,7 @@ func (p projLTFloat64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -37441,7 +37441,7 @@ func (p projLTFloat64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -37617,7 +37617,7 @@ func (p projLTFloat64Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -37771,7 +37771,7 @@ func (p projLTFloat64DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -37901,7 +37901,7 @@ func (p projLTTimestampTimestampOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -38015,7 +38015,7 @@ func (p projLTIntervalIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -38125,7 +38125,7 @@ func (p projLTJSONJSONOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -38238,7 +38238,7 @@ func (p projLTDatumDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -38356,7 +38356,7 @@ func (p projLEBoolBoolOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -38470,7 +38470,7 @@ func (p projLEBytesBytesOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -38580,7 +38580,7 @@ func (p projLEDecimalInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -38704,7 +38704,7 @@ func (p projLEDecimalInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -38828,7 +38828,7 @@ func (p projLEDecimalInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -38956,7 +38956,7 @@ func (p projLEDecimalFloat64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -39072,7 +39072,7 @@ func (p projLEDecimalDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -39194,7 +39194,7 @@ func (p projLEInt16Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -39338,7 +39338,7 @@ func (p projLEInt16Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -39482,7 +39482,7 @@ func (p projLEInt16Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -39642,7 +39642,7 @@ func (p projLEInt16Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -39792,7 +39792,7 @@ func (p projLEInt16DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -39926,7 +39926,7 @@ func (p projLEInt32Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -40070,7 +40070,7 @@ func (p projLEInt32Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -40214,7 +40214,7 @@ func (p projLEInt32Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -40374,7 +40374,7 @@ func (p projLEInt32Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -40524,7 +40524,7 @@ func (p projLEInt32DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -40658,7 +40658,7 @@ func (p projLEInt64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -40802,7 +40802,7 @@ func (p projLEInt64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -40946,7 +40946,7 @@ func (p projLEInt64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -41106,7 +41106,7 @@ func (p projLEInt64Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -41256,7 +41256,7 @@ func (p projLEInt64DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -41406,7 +41406,7 @@ func (p projLEFloat64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -41582,7 +41582,7 @@ func (p projLEFloat64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -41758,7 +41758,7 @@ func (p projLEFloat64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -41934,7 +41934,7 @@ func (p projLEFloat64Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -42088,7 +42088,7 @@ func (p projLEFloat64DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -42218,7 +42218,7 @@ func (p projLETimestampTimestampOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -42332,7 +42332,7 @@ func (p projLEIntervalIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -42442,7 +42442,7 @@ func (p projLEJSONJSONOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -42555,7 +42555,7 @@ func (p projLEDatumDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -42673,7 +42673,7 @@ func (p projGTBoolBoolOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -42787,7 +42787,7 @@ func (p projGTBytesBytesOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -42897,7 +42897,7 @@ func (p projGTDecimalInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -43021,7 +43021,7 @@ func (p projGTDecimalInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -43145,7 +43145,7 @@ func (p projGTDecimalInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -43273,7 +43273,7 @@ func (p projGTDecimalFloat64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -43389,7 +43389,7 @@ func (p projGTDecimalDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -43511,7 +43511,7 @@ func (p projGTInt16Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -43655,7 +43655,7 @@ func (p projGTInt16Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -43799,7 +43799,7 @@ func (p projGTInt16Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -43959,7 +43959,7 @@ func (p projGTInt16Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -44109,7 +44109,7 @@ func (p projGTInt16DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -44243,7 +44243,7 @@ func (p projGTInt32Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -44387,7 +44387,7 @@ func (p projGTInt32Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -44531,7 +44531,7 @@ func (p projGTInt32Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -44691,7 +44691,7 @@ func (p projGTInt32Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -44841,7 +44841,7 @@ func (p projGTInt32DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -44975,7 +44975,7 @@ func (p projGTInt64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -45119,7 +45119,7 @@ func (p projGTInt64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -45263,7 +45263,7 @@ func (p projGTInt64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -45423,7 +45423,7 @@ func (p projGTInt64Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -45573,7 +45573,7 @@ func (p projGTInt64DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -45723,7 +45723,7 @@ func (p projGTFloat64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -45899,7 +45899,7 @@ func (p projGTFloat64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -46075,7 +46075,7 @@ func (p projGTFloat64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -46251,7 +46251,7 @@ func (p projGTFloat64Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -46405,7 +46405,7 @@ func (p projGTFloat64DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -46535,7 +46535,7 @@ func (p projGTTimestampTimestampOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -46649,7 +46649,7 @@ func (p projGTIntervalIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -46759,7 +46759,7 @@ func (p projGTJSONJSONOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -46872,7 +46872,7 @@ func (p projGTDatumDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -46990,7 +46990,7 @@ func (p projGEBoolBoolOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -47104,7 +47104,7 @@ func (p projGEBytesBytesOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -47214,7 +47214,7 @@ func (p projGEDecimalInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -47338,7 +47338,7 @@ func (p projGEDecimalInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -47462,7 +47462,7 @@ func (p projGEDecimalInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -47590,7 +47590,7 @@ func (p projGEDecimalFloat64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -47706,7 +47706,7 @@ func (p projGEDecimalDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -47828,7 +47828,7 @@ func (p projGEInt16Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -47972,7 +47972,7 @@ func (p projGEInt16Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -48116,7 +48116,7 @@ func (p projGEInt16Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -48276,7 +48276,7 @@ func (p projGEInt16Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -48426,7 +48426,7 @@ func (p projGEInt16DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -48560,7 +48560,7 @@ func (p projGEInt32Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -48704,7 +48704,7 @@ func (p projGEInt32Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -48848,7 +48848,7 @@ func (p projGEInt32Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -49008,7 +49008,7 @@ func (p projGEInt32Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -49158,7 +49158,7 @@ func (p projGEInt32DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -49292,7 +49292,7 @@ func (p projGEInt64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -49436,7 +49436,7 @@ func (p projGEInt64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -49580,7 +49580,7 @@ func (p projGEInt64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -49740,7 +49740,7 @@ func (p projGEInt64Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -49890,7 +49890,7 @@ func (p projGEInt64DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -50040,7 +50040,7 @@ func (p projGEFloat64Int16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -50216,7 +50216,7 @@ func (p projGEFloat64Int32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -50392,7 +50392,7 @@ func (p projGEFloat64Int64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -50568,7 +50568,7 @@ func (p projGEFloat64Float64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -50722,7 +50722,7 @@ func (p projGEFloat64DecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -50852,7 +50852,7 @@ func (p projGETimestampTimestampOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -50966,7 +50966,7 @@ func (p projGEIntervalIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -51076,7 +51076,7 @@ func (p projGEJSONJSONOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -51189,7 +51189,7 @@ func (p projGEDatumDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*col1Nulls).Or(*col2Nulls))
+			*_outNulls = _outNulls.Or(*col1Nulls).Or(*col2Nulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
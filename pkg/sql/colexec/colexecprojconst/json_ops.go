@@ -0,0 +1,107 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package colexecprojconst
+
+import (
+	"github.com/cockroachdb/cockroach/pkg/col/coldata"
+	"github.com/cockroachdb/cockroach/pkg/sql/colexec/colexecutils"
+	"github.com/cockroachdb/cockroach/pkg/sql/colexecerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/colexecop"
+	"github.com/cockroachdb/cockroach/pkg/sql/colmem"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/json"
+)
+
+// GetJSONContainsProjectionOperator returns a projection operator which
+// projects whether the JSON value in colIdx contains (@>) the constant JSON
+// value, or is contained by it (<@) if the containedBy argument is true.
+func GetJSONContainsProjectionOperator(
+	allocator *colmem.Allocator,
+	input colexecop.Operator,
+	colIdx int,
+	resultIdx int,
+	constArg json.JSON,
+	containedBy bool,
+) colexecop.Operator {
+	input = colexecutils.NewVectorTypeEnforcer(allocator, input, types.Bool, resultIdx)
+	return &projJSONContainsConstOp{
+		projConstOpBase: projConstOpBase{
+			OneInputHelper: colexecop.MakeOneInputHelper(input),
+			allocator:      allocator,
+			colIdx:         colIdx,
+			outputIdx:      resultIdx,
+		},
+		constArg:    constArg,
+		containedBy: containedBy,
+	}
+}
+
+type projJSONContainsConstOp struct {
+	projConstOpBase
+	constArg    json.JSON
+	containedBy bool
+}
+
+var _ colexecop.Operator = &projJSONContainsConstOp{}
+
+func (p *projJSONContainsConstOp) contains(arg json.JSON) bool {
+	var res bool
+	var err error
+	if p.containedBy {
+		res, err = json.Contains(p.constArg, arg)
+	} else {
+		res, err = json.Contains(arg, p.constArg)
+	}
+	if err != nil {
+		colexecerror.ExpectedError(err)
+	}
+	return res
+}
+
+func (p *projJSONContainsConstOp) Next() coldata.Batch {
+	batch := p.Input.Next()
+	n := batch.Length()
+	if n == 0 {
+		return coldata.ZeroBatch
+	}
+	vec := batch.ColVec(p.colIdx)
+	col := vec.JSON()
+	projVec := batch.ColVec(p.outputIdx)
+	p.allocator.PerformOperation([]coldata.Vec{projVec}, func() {
+		projCol := projVec.Bool()
+		var nulls *coldata.Nulls
+		if vec.MaybeHasNulls() {
+			nulls = vec.Nulls()
+		}
+		if sel := batch.Selection(); sel != nil {
+			sel = sel[:n]
+			for _, i := range sel {
+				if nulls != nil && nulls.NullAt(i) {
+					// We only want to perform the projection operation if the
+					// value is not null.
+					continue
+				}
+				projCol[i] = p.contains(col.Get(i))
+			}
+		} else {
+			for i := 0; i < n; i++ {
+				if nulls != nil && nulls.NullAt(i) {
+					continue
+				}
+				projCol[i] = p.contains(col.Get(i))
+			}
+		}
+		if nulls != nil {
+			projVec.SetNulls(projVec.Nulls().Or(*nulls))
+		}
+	})
+	return batch
+}
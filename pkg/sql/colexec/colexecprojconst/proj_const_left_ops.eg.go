@@ -95,7 +95,7 @@ func (p projBitandInt16ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -171,7 +171,7 @@ func (p projBitandInt16ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -247,7 +247,7 @@ func (p projBitandInt16ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -323,7 +323,7 @@ func (p projBitandInt32ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -399,7 +399,7 @@ func (p projBitandInt32ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -475,7 +475,7 @@ func (p projBitandInt32ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -551,7 +551,7 @@ func (p projBitandInt64ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -627,7 +627,7 @@ func (p projBitandInt64ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -703,7 +703,7 @@ func (p projBitandInt64ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -794,7 +794,7 @@ func (p projBitandDatumConstDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -883,7 +883,7 @@ func (p projBitorInt16ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -959,7 +959,7 @@ func (p projBitorInt16ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1035,7 +1035,7 @@ func (p projBitorInt16ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1111,7 +1111,7 @@ func (p projBitorInt32ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1187,7 +1187,7 @@ func (p projBitorInt32ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1263,7 +1263,7 @@ func (p projBitorInt32ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1339,7 +1339,7 @@ func (p projBitorInt64ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1415,7 +1415,7 @@ func (p projBitorInt64ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1491,7 +1491,7 @@ func (p projBitorInt64ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1582,7 +1582,7 @@ func (p projBitorDatumConstDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1671,7 +1671,7 @@ func (p projBitxorInt16ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1747,7 +1747,7 @@ func (p projBitxorInt16ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1823,7 +1823,7 @@ func (p projBitxorInt16ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1899,7 +1899,7 @@ func (p projBitxorInt32ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1975,7 +1975,7 @@ func (p projBitxorInt32ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2051,7 +2051,7 @@ func (p projBitxorInt32ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2127,7 +2127,7 @@ func (p projBitxorInt64ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2203,7 +2203,7 @@ func (p projBitxorInt64ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2279,7 +2279,7 @@ func (p projBitxorInt64ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2370,7 +2370,7 @@ func (p projBitxorDatumConstDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2473,7 +2473,7 @@ func (p projPlusDecimalConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2577,7 +2577,7 @@ func (p projPlusDecimalConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2681,7 +2681,7 @@ func (p projPlusDecimalConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2783,7 +2783,7 @@ func (p projPlusDecimalConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2883,7 +2883,7 @@ func (p projPlusInt16ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2983,7 +2983,7 @@ func (p projPlusInt16ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3083,7 +3083,7 @@ func (p projPlusInt16ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3187,7 +3187,7 @@ func (p projPlusInt16ConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3303,7 +3303,7 @@ func (p projPlusInt16ConstDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3413,7 +3413,7 @@ func (p projPlusInt32ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3513,7 +3513,7 @@ func (p projPlusInt32ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3613,7 +3613,7 @@ func (p projPlusInt32ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3717,7 +3717,7 @@ func (p projPlusInt32ConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3833,7 +3833,7 @@ func (p projPlusInt32ConstDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3943,7 +3943,7 @@ func (p projPlusInt64ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4043,7 +4043,7 @@ func (p projPlusInt64ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4143,7 +4143,7 @@ func (p projPlusInt64ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4247,7 +4247,7 @@ func (p projPlusInt64ConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4363,7 +4363,7 @@ func (p projPlusInt64ConstDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4467,7 +4467,7 @@ func (p projPlusFloat64ConstFloat64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4555,7 +4555,7 @@ func (p projPlusTimestampConstIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4643,7 +4643,7 @@ func (p projPlusIntervalConstTimestampOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4721,7 +4721,7 @@ func (p projPlusIntervalConstIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4817,7 +4817,7 @@ func (p projPlusIntervalConstDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4938,7 +4938,7 @@ func (p projPlusDatumConstIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5058,7 +5058,7 @@ func (p projPlusDatumConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5178,7 +5178,7 @@ func (p projPlusDatumConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5298,7 +5298,7 @@ func (p projPlusDatumConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5409,7 +5409,7 @@ func (p projMinusDecimalConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5513,7 +5513,7 @@ func (p projMinusDecimalConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5617,7 +5617,7 @@ func (p projMinusDecimalConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5719,7 +5719,7 @@ func (p projMinusDecimalConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5819,7 +5819,7 @@ func (p projMinusInt16ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5919,7 +5919,7 @@ func (p projMinusInt16ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6019,7 +6019,7 @@ func (p projMinusInt16ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6123,7 +6123,7 @@ func (p projMinusInt16ConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6239,7 +6239,7 @@ func (p projMinusInt16ConstDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6349,7 +6349,7 @@ func (p projMinusInt32ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6449,7 +6449,7 @@ func (p projMinusInt32ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6549,7 +6549,7 @@ func (p projMinusInt32ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6653,7 +6653,7 @@ func (p projMinusInt32ConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6769,7 +6769,7 @@ func (p projMinusInt32ConstDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6879,7 +6879,7 @@ func (p projMinusInt64ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6979,7 +6979,7 @@ func (p projMinusInt64ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7079,7 +7079,7 @@ func (p projMinusInt64ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7183,7 +7183,7 @@ func (p projMinusInt64ConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7299,7 +7299,7 @@ func (p projMinusInt64ConstDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7403,7 +7403,7 @@ func (p projMinusFloat64ConstFloat64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7487,7 +7487,7 @@ func (p projMinusTimestampConstTimestampOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7571,7 +7571,7 @@ func (p projMinusTimestampConstIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7649,7 +7649,7 @@ func (p projMinusIntervalConstIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7745,7 +7745,7 @@ func (p projMinusIntervalConstDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7856,7 +7856,7 @@ func (p projMinusJSONConstBytesOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7950,7 +7950,7 @@ func (p projMinusJSONConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8036,7 +8036,7 @@ func (p projMinusJSONConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8122,7 +8122,7 @@ func (p projMinusJSONConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8218,7 +8218,7 @@ func (p projMinusDatumConstDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8330,7 +8330,7 @@ func (p projMinusDatumConstIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8450,7 +8450,7 @@ func (p projMinusDatumConstBytesOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8570,7 +8570,7 @@ func (p projMinusDatumConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8690,7 +8690,7 @@ func (p projMinusDatumConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8810,7 +8810,7 @@ func (p projMinusDatumConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8921,7 +8921,7 @@ func (p projMultDecimalConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9025,7 +9025,7 @@ func (p projMultDecimalConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9129,7 +9129,7 @@ func (p projMultDecimalConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9231,7 +9231,7 @@ func (p projMultDecimalConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9325,7 +9325,7 @@ func (p projMultDecimalConstIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9435,7 +9435,7 @@ func (p projMultInt16ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9567,7 +9567,7 @@ func (p projMultInt16ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9699,7 +9699,7 @@ func (p projMultInt16ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9819,7 +9819,7 @@ func (p projMultInt16ConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9907,7 +9907,7 @@ func (p projMultInt16ConstIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10007,7 +10007,7 @@ func (p projMultInt32ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10139,7 +10139,7 @@ func (p projMultInt32ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10271,7 +10271,7 @@ func (p projMultInt32ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10391,7 +10391,7 @@ func (p projMultInt32ConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10479,7 +10479,7 @@ func (p projMultInt32ConstIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10579,7 +10579,7 @@ func (p projMultInt64ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10711,7 +10711,7 @@ func (p projMultInt64ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10843,7 +10843,7 @@ func (p projMultInt64ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10963,7 +10963,7 @@ func (p projMultInt64ConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11051,7 +11051,7 @@ func (p projMultInt64ConstIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11129,7 +11129,7 @@ func (p projMultFloat64ConstFloat64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11207,7 +11207,7 @@ func (p projMultFloat64ConstIntervalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11275,7 +11275,7 @@ func (p projMultIntervalConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11343,7 +11343,7 @@ func (p projMultIntervalConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11411,7 +11411,7 @@ func (p projMultIntervalConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11479,7 +11479,7 @@ func (p projMultIntervalConstFloat64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11557,7 +11557,7 @@ func (p projMultIntervalConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11661,7 +11661,7 @@ func (p projDivDecimalConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11781,7 +11781,7 @@ func (p projDivDecimalConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11901,7 +11901,7 @@ func (p projDivDecimalConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12019,7 +12019,7 @@ func (p projDivDecimalConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12135,7 +12135,7 @@ func (p projDivInt16ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12251,7 +12251,7 @@ func (p projDivInt16ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12367,7 +12367,7 @@ func (p projDivInt16ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12487,7 +12487,7 @@ func (p projDivInt16ConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12607,7 +12607,7 @@ func (p projDivInt32ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12723,7 +12723,7 @@ func (p projDivInt32ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12839,7 +12839,7 @@ func (p projDivInt32ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12959,7 +12959,7 @@ func (p projDivInt32ConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13079,7 +13079,7 @@ func (p projDivInt64ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13195,7 +13195,7 @@ func (p projDivInt64ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13311,7 +13311,7 @@ func (p projDivInt64ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13431,7 +13431,7 @@ func (p projDivInt64ConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13545,7 +13545,7 @@ func (p projDivFloat64ConstFloat64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13639,7 +13639,7 @@ func (p projDivIntervalConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13723,7 +13723,7 @@ func (p projDivIntervalConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13807,7 +13807,7 @@ func (p projDivIntervalConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13891,7 +13891,7 @@ func (p projDivIntervalConstFloat64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13993,7 +13993,7 @@ func (p projFloorDivDecimalConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14113,7 +14113,7 @@ func (p projFloorDivDecimalConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14233,7 +14233,7 @@ func (p projFloorDivDecimalConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14351,7 +14351,7 @@ func (p projFloorDivDecimalConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14457,7 +14457,7 @@ func (p projFloorDivInt16ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14553,7 +14553,7 @@ func (p projFloorDivInt16ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14649,7 +14649,7 @@ func (p projFloorDivInt16ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14759,7 +14759,7 @@ func (p projFloorDivInt16ConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14869,7 +14869,7 @@ func (p projFloorDivInt32ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14965,7 +14965,7 @@ func (p projFloorDivInt32ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15061,7 +15061,7 @@ func (p projFloorDivInt32ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15171,7 +15171,7 @@ func (p projFloorDivInt32ConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15281,7 +15281,7 @@ func (p projFloorDivInt64ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15377,7 +15377,7 @@ func (p projFloorDivInt64ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15473,7 +15473,7 @@ func (p projFloorDivInt64ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15583,7 +15583,7 @@ func (p projFloorDivInt64ConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15697,7 +15697,7 @@ func (p projFloorDivFloat64ConstFloat64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15809,7 +15809,7 @@ func (p projModDecimalConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15929,7 +15929,7 @@ func (p projModDecimalConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16049,7 +16049,7 @@ func (p projModDecimalConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16167,7 +16167,7 @@ func (p projModDecimalConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16273,7 +16273,7 @@ func (p projModInt16ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16369,7 +16369,7 @@ func (p projModInt16ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16465,7 +16465,7 @@ func (p projModInt16ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16575,7 +16575,7 @@ func (p projModInt16ConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16685,7 +16685,7 @@ func (p projModInt32ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16781,7 +16781,7 @@ func (p projModInt32ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16877,7 +16877,7 @@ func (p projModInt32ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16987,7 +16987,7 @@ func (p projModInt32ConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17097,7 +17097,7 @@ func (p projModInt64ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17193,7 +17193,7 @@ func (p projModInt64ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17289,7 +17289,7 @@ func (p projModInt64ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17399,7 +17399,7 @@ func (p projModInt64ConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17513,7 +17513,7 @@ func (p projModFloat64ConstFloat64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17617,7 +17617,7 @@ func (p projPowDecimalConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17721,7 +17721,7 @@ func (p projPowDecimalConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17825,7 +17825,7 @@ func (p projPowDecimalConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17927,7 +17927,7 @@ func (p projPowDecimalConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18039,7 +18039,7 @@ func (p projPowInt16ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18163,7 +18163,7 @@ func (p projPowInt16ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18287,7 +18287,7 @@ func (p projPowInt16ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18403,7 +18403,7 @@ func (p projPowInt16ConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18519,7 +18519,7 @@ func (p projPowInt32ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18643,7 +18643,7 @@ func (p projPowInt32ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18767,7 +18767,7 @@ func (p projPowInt32ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18883,7 +18883,7 @@ func (p projPowInt32ConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18999,7 +18999,7 @@ func (p projPowInt64ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19123,7 +19123,7 @@ func (p projPowInt64ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19247,7 +19247,7 @@ func (p projPowInt64ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19363,7 +19363,7 @@ func (p projPowInt64ConstDecimalOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19461,7 +19461,7 @@ func (p projPowFloat64ConstFloat64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19552,7 +19552,7 @@ func (p projConcatBytesConstBytesOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19644,7 +19644,7 @@ func (p projConcatJSONConstJSONOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19742,7 +19742,7 @@ func (p projConcatDatumConstDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19843,7 +19843,7 @@ func (p projLShiftInt16ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19943,7 +19943,7 @@ func (p projLShiftInt16ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20043,7 +20043,7 @@ func (p projLShiftInt16ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20143,7 +20143,7 @@ func (p projLShiftInt32ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20243,7 +20243,7 @@ func (p projLShiftInt32ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20343,7 +20343,7 @@ func (p projLShiftInt32ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20443,7 +20443,7 @@ func (p projLShiftInt64ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20543,7 +20543,7 @@ func (p projLShiftInt64ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20643,7 +20643,7 @@ func (p projLShiftInt64ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20754,7 +20754,7 @@ func (p projLShiftDatumConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20874,7 +20874,7 @@ func (p projLShiftDatumConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20994,7 +20994,7 @@ func (p projLShiftDatumConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21103,7 +21103,7 @@ func (p projRShiftInt16ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21203,7 +21203,7 @@ func (p projRShiftInt16ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21303,7 +21303,7 @@ func (p projRShiftInt16ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21403,7 +21403,7 @@ func (p projRShiftInt32ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21503,7 +21503,7 @@ func (p projRShiftInt32ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21603,7 +21603,7 @@ func (p projRShiftInt32ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21703,7 +21703,7 @@ func (p projRShiftInt64ConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21803,7 +21803,7 @@ func (p projRShiftInt64ConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21903,7 +21903,7 @@ func (p projRShiftInt64ConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22014,7 +22014,7 @@ func (p projRShiftDatumConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22134,7 +22134,7 @@ func (p projRShiftDatumConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22254,7 +22254,7 @@ func (p projRShiftDatumConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22370,7 +22370,7 @@ func (p projJSONFetchValJSONConstBytesOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22478,7 +22478,7 @@ func (p projJSONFetchValJSONConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22580,7 +22580,7 @@ func (p projJSONFetchValJSONConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22682,7 +22682,7 @@ func (p projJSONFetchValJSONConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22808,7 +22808,7 @@ func (p projJSONFetchTextJSONConstBytesOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22952,7 +22952,7 @@ func (p projJSONFetchTextJSONConstInt16Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -23090,7 +23090,7 @@ func (p projJSONFetchTextJSONConstInt32Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -23228,7 +23228,7 @@ func (p projJSONFetchTextJSONConstInt64Op) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -23348,7 +23348,7 @@ func (p projJSONFetchValPathJSONConstDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -23470,7 +23470,7 @@ func (p projJSONFetchTextPathJSONConstDatumOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
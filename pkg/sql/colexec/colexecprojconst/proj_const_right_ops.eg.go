@@ -98,7 +98,7 @@ func (p projBitandInt16Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -174,7 +174,7 @@ func (p projBitandInt16Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -250,7 +250,7 @@ func (p projBitandInt16Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -326,7 +326,7 @@ func (p projBitandInt32Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -402,7 +402,7 @@ func (p projBitandInt32Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -478,7 +478,7 @@ func (p projBitandInt32Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -554,7 +554,7 @@ func (p projBitandInt64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -630,7 +630,7 @@ func (p projBitandInt64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -706,7 +706,7 @@ func (p projBitandInt64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -797,7 +797,7 @@ func (p projBitandDatumDatumConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -886,7 +886,7 @@ func (p projBitorInt16Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -962,7 +962,7 @@ func (p projBitorInt16Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1038,7 +1038,7 @@ func (p projBitorInt16Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1114,7 +1114,7 @@ func (p projBitorInt32Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1190,7 +1190,7 @@ func (p projBitorInt32Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1266,7 +1266,7 @@ func (p projBitorInt32Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1342,7 +1342,7 @@ func (p projBitorInt64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1418,7 +1418,7 @@ func (p projBitorInt64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1494,7 +1494,7 @@ func (p projBitorInt64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1585,7 +1585,7 @@ func (p projBitorDatumDatumConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1674,7 +1674,7 @@ func (p projBitxorInt16Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1750,7 +1750,7 @@ func (p projBitxorInt16Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1826,7 +1826,7 @@ func (p projBitxorInt16Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1902,7 +1902,7 @@ func (p projBitxorInt32Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -1978,7 +1978,7 @@ func (p projBitxorInt32Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2054,7 +2054,7 @@ func (p projBitxorInt32Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2130,7 +2130,7 @@ func (p projBitxorInt64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2206,7 +2206,7 @@ func (p projBitxorInt64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2282,7 +2282,7 @@ func (p projBitxorInt64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2373,7 +2373,7 @@ func (p projBitxorDatumDatumConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2476,7 +2476,7 @@ func (p projPlusDecimalInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2580,7 +2580,7 @@ func (p projPlusDecimalInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2684,7 +2684,7 @@ func (p projPlusDecimalInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2786,7 +2786,7 @@ func (p projPlusDecimalDecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2886,7 +2886,7 @@ func (p projPlusInt16Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -2986,7 +2986,7 @@ func (p projPlusInt16Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3086,7 +3086,7 @@ func (p projPlusInt16Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3190,7 +3190,7 @@ func (p projPlusInt16DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3305,7 +3305,7 @@ func (p projPlusInt16DatumConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3414,7 +3414,7 @@ func (p projPlusInt32Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3514,7 +3514,7 @@ func (p projPlusInt32Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3614,7 +3614,7 @@ func (p projPlusInt32Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3718,7 +3718,7 @@ func (p projPlusInt32DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3833,7 +3833,7 @@ func (p projPlusInt32DatumConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -3942,7 +3942,7 @@ func (p projPlusInt64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4042,7 +4042,7 @@ func (p projPlusInt64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4142,7 +4142,7 @@ func (p projPlusInt64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4246,7 +4246,7 @@ func (p projPlusInt64DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4361,7 +4361,7 @@ func (p projPlusInt64DatumConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4464,7 +4464,7 @@ func (p projPlusFloat64Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4552,7 +4552,7 @@ func (p projPlusTimestampIntervalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4640,7 +4640,7 @@ func (p projPlusIntervalTimestampConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4718,7 +4718,7 @@ func (p projPlusIntervalIntervalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4813,7 +4813,7 @@ func (p projPlusIntervalDatumConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -4934,7 +4934,7 @@ func (p projPlusDatumIntervalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5056,7 +5056,7 @@ func (p projPlusDatumInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5178,7 +5178,7 @@ func (p projPlusDatumInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5300,7 +5300,7 @@ func (p projPlusDatumInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5412,7 +5412,7 @@ func (p projMinusDecimalInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5516,7 +5516,7 @@ func (p projMinusDecimalInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5620,7 +5620,7 @@ func (p projMinusDecimalInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5722,7 +5722,7 @@ func (p projMinusDecimalDecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5822,7 +5822,7 @@ func (p projMinusInt16Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -5922,7 +5922,7 @@ func (p projMinusInt16Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6022,7 +6022,7 @@ func (p projMinusInt16Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6126,7 +6126,7 @@ func (p projMinusInt16DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6241,7 +6241,7 @@ func (p projMinusInt16DatumConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6350,7 +6350,7 @@ func (p projMinusInt32Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6450,7 +6450,7 @@ func (p projMinusInt32Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6550,7 +6550,7 @@ func (p projMinusInt32Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6654,7 +6654,7 @@ func (p projMinusInt32DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6769,7 +6769,7 @@ func (p projMinusInt32DatumConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6878,7 +6878,7 @@ func (p projMinusInt64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -6978,7 +6978,7 @@ func (p projMinusInt64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7078,7 +7078,7 @@ func (p projMinusInt64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7182,7 +7182,7 @@ func (p projMinusInt64DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7297,7 +7297,7 @@ func (p projMinusInt64DatumConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7400,7 +7400,7 @@ func (p projMinusFloat64Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7484,7 +7484,7 @@ func (p projMinusTimestampTimestampConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7568,7 +7568,7 @@ func (p projMinusTimestampIntervalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7646,7 +7646,7 @@ func (p projMinusIntervalIntervalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7741,7 +7741,7 @@ func (p projMinusIntervalDatumConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7851,7 +7851,7 @@ func (p projMinusJSONBytesConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -7946,7 +7946,7 @@ func (p projMinusJSONInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8034,7 +8034,7 @@ func (p projMinusJSONInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8122,7 +8122,7 @@ func (p projMinusJSONInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8219,7 +8219,7 @@ func (p projMinusDatumDatumConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8332,7 +8332,7 @@ func (p projMinusDatumIntervalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8453,7 +8453,7 @@ func (p projMinusDatumBytesConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8574,7 +8574,7 @@ func (p projMinusDatumInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8696,7 +8696,7 @@ func (p projMinusDatumInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8818,7 +8818,7 @@ func (p projMinusDatumInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -8930,7 +8930,7 @@ func (p projMultDecimalInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9034,7 +9034,7 @@ func (p projMultDecimalInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9138,7 +9138,7 @@ func (p projMultDecimalInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9240,7 +9240,7 @@ func (p projMultDecimalDecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9334,7 +9334,7 @@ func (p projMultDecimalIntervalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9444,7 +9444,7 @@ func (p projMultInt16Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9576,7 +9576,7 @@ func (p projMultInt16Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9708,7 +9708,7 @@ func (p projMultInt16Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9828,7 +9828,7 @@ func (p projMultInt16DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -9916,7 +9916,7 @@ func (p projMultInt16IntervalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10016,7 +10016,7 @@ func (p projMultInt32Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10148,7 +10148,7 @@ func (p projMultInt32Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10280,7 +10280,7 @@ func (p projMultInt32Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10400,7 +10400,7 @@ func (p projMultInt32DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10488,7 +10488,7 @@ func (p projMultInt32IntervalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10588,7 +10588,7 @@ func (p projMultInt64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10720,7 +10720,7 @@ func (p projMultInt64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10852,7 +10852,7 @@ func (p projMultInt64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -10972,7 +10972,7 @@ func (p projMultInt64DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11060,7 +11060,7 @@ func (p projMultInt64IntervalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11138,7 +11138,7 @@ func (p projMultFloat64Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11216,7 +11216,7 @@ func (p projMultFloat64IntervalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11284,7 +11284,7 @@ func (p projMultIntervalInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11352,7 +11352,7 @@ func (p projMultIntervalInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11420,7 +11420,7 @@ func (p projMultIntervalInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11488,7 +11488,7 @@ func (p projMultIntervalFloat64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11566,7 +11566,7 @@ func (p projMultIntervalDecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11670,7 +11670,7 @@ func (p projDivDecimalInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11790,7 +11790,7 @@ func (p projDivDecimalInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -11910,7 +11910,7 @@ func (p projDivDecimalInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12028,7 +12028,7 @@ func (p projDivDecimalDecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12144,7 +12144,7 @@ func (p projDivInt16Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12260,7 +12260,7 @@ func (p projDivInt16Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12376,7 +12376,7 @@ func (p projDivInt16Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12496,7 +12496,7 @@ func (p projDivInt16DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12616,7 +12616,7 @@ func (p projDivInt32Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12732,7 +12732,7 @@ func (p projDivInt32Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12848,7 +12848,7 @@ func (p projDivInt32Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -12968,7 +12968,7 @@ func (p projDivInt32DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13088,7 +13088,7 @@ func (p projDivInt64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13204,7 +13204,7 @@ func (p projDivInt64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13320,7 +13320,7 @@ func (p projDivInt64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13440,7 +13440,7 @@ func (p projDivInt64DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13554,7 +13554,7 @@ func (p projDivFloat64Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13648,7 +13648,7 @@ func (p projDivIntervalInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13732,7 +13732,7 @@ func (p projDivIntervalInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13816,7 +13816,7 @@ func (p projDivIntervalInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -13900,7 +13900,7 @@ func (p projDivIntervalFloat64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14002,7 +14002,7 @@ func (p projFloorDivDecimalInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14122,7 +14122,7 @@ func (p projFloorDivDecimalInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14242,7 +14242,7 @@ func (p projFloorDivDecimalInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14360,7 +14360,7 @@ func (p projFloorDivDecimalDecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14466,7 +14466,7 @@ func (p projFloorDivInt16Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14562,7 +14562,7 @@ func (p projFloorDivInt16Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14658,7 +14658,7 @@ func (p projFloorDivInt16Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14768,7 +14768,7 @@ func (p projFloorDivInt16DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14878,7 +14878,7 @@ func (p projFloorDivInt32Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -14974,7 +14974,7 @@ func (p projFloorDivInt32Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15070,7 +15070,7 @@ func (p projFloorDivInt32Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15180,7 +15180,7 @@ func (p projFloorDivInt32DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15290,7 +15290,7 @@ func (p projFloorDivInt64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15386,7 +15386,7 @@ func (p projFloorDivInt64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15482,7 +15482,7 @@ func (p projFloorDivInt64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15592,7 +15592,7 @@ func (p projFloorDivInt64DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15706,7 +15706,7 @@ func (p projFloorDivFloat64Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15818,7 +15818,7 @@ func (p projModDecimalInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -15938,7 +15938,7 @@ func (p projModDecimalInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16058,7 +16058,7 @@ func (p projModDecimalInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16176,7 +16176,7 @@ func (p projModDecimalDecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16282,7 +16282,7 @@ func (p projModInt16Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16378,7 +16378,7 @@ func (p projModInt16Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16474,7 +16474,7 @@ func (p projModInt16Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16584,7 +16584,7 @@ func (p projModInt16DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16694,7 +16694,7 @@ func (p projModInt32Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16790,7 +16790,7 @@ func (p projModInt32Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16886,7 +16886,7 @@ func (p projModInt32Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -16996,7 +16996,7 @@ func (p projModInt32DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17106,7 +17106,7 @@ func (p projModInt64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17202,7 +17202,7 @@ func (p projModInt64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17298,7 +17298,7 @@ func (p projModInt64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17408,7 +17408,7 @@ func (p projModInt64DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17522,7 +17522,7 @@ func (p projModFloat64Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17626,7 +17626,7 @@ func (p projPowDecimalInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17730,7 +17730,7 @@ func (p projPowDecimalInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17834,7 +17834,7 @@ func (p projPowDecimalInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -17936,7 +17936,7 @@ func (p projPowDecimalDecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18048,7 +18048,7 @@ func (p projPowInt16Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18172,7 +18172,7 @@ func (p projPowInt16Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18296,7 +18296,7 @@ func (p projPowInt16Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18412,7 +18412,7 @@ func (p projPowInt16DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18528,7 +18528,7 @@ func (p projPowInt32Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18652,7 +18652,7 @@ func (p projPowInt32Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18776,7 +18776,7 @@ func (p projPowInt32Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -18892,7 +18892,7 @@ func (p projPowInt32DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19008,7 +19008,7 @@ func (p projPowInt64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19132,7 +19132,7 @@ func (p projPowInt64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19256,7 +19256,7 @@ func (p projPowInt64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19372,7 +19372,7 @@ func (p projPowInt64DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19470,7 +19470,7 @@ func (p projPowFloat64Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19561,7 +19561,7 @@ func (p projConcatBytesBytesConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19653,7 +19653,7 @@ func (p projConcatJSONJSONConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19751,7 +19751,7 @@ func (p projConcatDatumDatumConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19852,7 +19852,7 @@ func (p projLShiftInt16Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -19952,7 +19952,7 @@ func (p projLShiftInt16Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20052,7 +20052,7 @@ func (p projLShiftInt16Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20152,7 +20152,7 @@ func (p projLShiftInt32Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20252,7 +20252,7 @@ func (p projLShiftInt32Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20352,7 +20352,7 @@ func (p projLShiftInt32Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20452,7 +20452,7 @@ func (p projLShiftInt64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20552,7 +20552,7 @@ func (p projLShiftInt64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20652,7 +20652,7 @@ func (p projLShiftInt64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20764,7 +20764,7 @@ func (p projLShiftDatumInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -20886,7 +20886,7 @@ func (p projLShiftDatumInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21008,7 +21008,7 @@ func (p projLShiftDatumInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21118,7 +21118,7 @@ func (p projRShiftInt16Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21218,7 +21218,7 @@ func (p projRShiftInt16Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21318,7 +21318,7 @@ func (p projRShiftInt16Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21418,7 +21418,7 @@ func (p projRShiftInt32Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21518,7 +21518,7 @@ func (p projRShiftInt32Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21618,7 +21618,7 @@ func (p projRShiftInt32Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21718,7 +21718,7 @@ func (p projRShiftInt64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21818,7 +21818,7 @@ func (p projRShiftInt64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -21918,7 +21918,7 @@ func (p projRShiftInt64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22030,7 +22030,7 @@ func (p projRShiftDatumInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22152,7 +22152,7 @@ func (p projRShiftDatumInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22274,7 +22274,7 @@ func (p projRShiftDatumInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22391,7 +22391,7 @@ func (p projJSONFetchValJSONBytesConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22500,7 +22500,7 @@ func (p projJSONFetchValJSONInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22604,7 +22604,7 @@ func (p projJSONFetchValJSONInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22708,7 +22708,7 @@ func (p projJSONFetchValJSONInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22835,7 +22835,7 @@ func (p projJSONFetchTextJSONBytesConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -22980,7 +22980,7 @@ func (p projJSONFetchTextJSONInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -23120,7 +23120,7 @@ func (p projJSONFetchTextJSONInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -23260,7 +23260,7 @@ func (p projJSONFetchTextJSONInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -23381,7 +23381,7 @@ func (p projJSONFetchValPathJSONDatumConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -23503,7 +23503,7 @@ func (p projJSONFetchTextPathJSONDatumConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -23636,7 +23636,7 @@ func (p projEQBoolBoolConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -23743,7 +23743,7 @@ func (p projEQBytesBytesConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -23846,7 +23846,7 @@ func (p projEQDecimalInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -23962,7 +23962,7 @@ func (p projEQDecimalInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -24078,7 +24078,7 @@ func (p projEQDecimalInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -24198,7 +24198,7 @@ func (p projEQDecimalFloat64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -24306,7 +24306,7 @@ func (p projEQDecimalDecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -24420,7 +24420,7 @@ func (p projEQInt16Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -24556,7 +24556,7 @@ func (p projEQInt16Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -24692,7 +24692,7 @@ func (p projEQInt16Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -24844,7 +24844,7 @@ func (p projEQInt16Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -24986,7 +24986,7 @@ func (p projEQInt16DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -25112,7 +25112,7 @@ func (p projEQInt32Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -25248,7 +25248,7 @@ func (p projEQInt32Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -25384,7 +25384,7 @@ func (p projEQInt32Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -25536,7 +25536,7 @@ func (p projEQInt32Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -25678,7 +25678,7 @@ func (p projEQInt32DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -25804,7 +25804,7 @@ func (p projEQInt64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -25940,7 +25940,7 @@ func (p projEQInt64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -26076,7 +26076,7 @@ func (p projEQInt64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -26228,7 +26228,7 @@ func (p projEQInt64Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -26370,7 +26370,7 @@ func (p projEQInt64DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -26512,7 +26512,7 @@ func (p projEQFloat64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -26680,7 +26680,7 @@ func (p projEQFloat64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -26848,7 +26848,7 @@ func (p projEQFloat64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -27016,7 +27016,7 @@ func (p projEQFloat64Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -27162,7 +27162,7 @@ func (p projEQFloat64DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -27284,7 +27284,7 @@ func (p projEQTimestampTimestampConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -27390,7 +27390,7 @@ func (p projEQIntervalIntervalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -27493,7 +27493,7 @@ func (p projEQJSONJSONConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -27599,7 +27599,7 @@ func (p projEQDatumDatumConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -27710,7 +27710,7 @@ func (p projNEBoolBoolConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -27817,7 +27817,7 @@ func (p projNEBytesBytesConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -27920,7 +27920,7 @@ func (p projNEDecimalInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -28036,7 +28036,7 @@ func (p projNEDecimalInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -28152,7 +28152,7 @@ func (p projNEDecimalInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -28272,7 +28272,7 @@ func (p projNEDecimalFloat64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -28380,7 +28380,7 @@ func (p projNEDecimalDecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -28494,7 +28494,7 @@ func (p projNEInt16Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -28630,7 +28630,7 @@ func (p projNEInt16Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -28766,7 +28766,7 @@ func (p projNEInt16Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -28918,7 +28918,7 @@ func (p projNEInt16Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -29060,7 +29060,7 @@ func (p projNEInt16DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -29186,7 +29186,7 @@ func (p projNEInt32Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -29322,7 +29322,7 @@ func (p projNEInt32Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -29458,7 +29458,7 @@ func (p projNEInt32Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -29610,7 +29610,7 @@ func (p projNEInt32Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -29752,7 +29752,7 @@ func (p projNEInt32DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -29878,7 +29878,7 @@ func (p projNEInt64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -30014,7 +30014,7 @@ func (p projNEInt64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -30150,7 +30150,7 @@ func (p projNEInt64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -30302,7 +30302,7 @@ func (p projNEInt64Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -30444,7 +30444,7 @@ func (p projNEInt64DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -30586,7 +30586,7 @@ func (p projNEFloat64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -30754,7 +30754,7 @@ func (p projNEFloat64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -30922,7 +30922,7 @@ func (p projNEFloat64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -31090,7 +31090,7 @@ func (p projNEFloat64Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -31236,7 +31236,7 @@ func (p projNEFloat64DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -31358,7 +31358,7 @@ func (p projNETimestampTimestampConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -31464,7 +31464,7 @@ func (p projNEIntervalIntervalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -31567,7 +31567,7 @@ func (p projNEJSONJSONConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -31673,7 +31673,7 @@ func (p projNEDatumDatumConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -31784,7 +31784,7 @@ func (p projLTBoolBoolConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -31891,7 +31891,7 @@ func (p projLTBytesBytesConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -31994,7 +31994,7 @@ func (p projLTDecimalInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -32110,7 +32110,7 @@ func (p projLTDecimalInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -32226,7 +32226,7 @@ func (p projLTDecimalInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -32346,7 +32346,7 @@ func (p projLTDecimalFloat64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -32454,7 +32454,7 @@ func (p projLTDecimalDecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -32568,7 +32568,7 @@ func (p projLTInt16Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -32704,7 +32704,7 @@ func (p projLTInt16Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -32840,7 +32840,7 @@ func (p projLTInt16Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -32992,7 +32992,7 @@ func (p projLTInt16Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -33134,7 +33134,7 @@ func (p projLTInt16DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -33260,7 +33260,7 @@ func (p projLTInt32Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -33396,7 +33396,7 @@ func (p projLTInt32Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -33532,7 +33532,7 @@ func (p projLTInt32Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -33684,7 +33684,7 @@ func (p projLTInt32Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -33826,7 +33826,7 @@ func (p projLTInt32DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -33952,7 +33952,7 @@ func (p projLTInt64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -34088,7 +34088,7 @@ func (p projLTInt64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -34224,7 +34224,7 @@ func (p projLTInt64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -34376,7 +34376,7 @@ func (p projLTInt64Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -34518,7 +34518,7 @@ func (p projLTInt64DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -34660,7 +34660,7 @@ func (p projLTFloat64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -34828,7 +34828,7 @@ func (p projLTFloat64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -34996,7 +34996,7 @@ func (p projLTFloat64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -35164,7 +35164,7 @@ func (p projLTFloat64Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -35310,7 +35310,7 @@ func (p projLTFloat64DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -35432,7 +35432,7 @@ func (p projLTTimestampTimestampConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -35538,7 +35538,7 @@ func (p projLTIntervalIntervalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -35641,7 +35641,7 @@ func (p projLTJSONJSONConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -35747,7 +35747,7 @@ func (p projLTDatumDatumConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -35858,7 +35858,7 @@ func (p projLEBoolBoolConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -35965,7 +35965,7 @@ func (p projLEBytesBytesConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -36068,7 +36068,7 @@ func (p projLEDecimalInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -36184,7 +36184,7 @@ func (p projLEDecimalInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -36300,7 +36300,7 @@ func (p projLEDecimalInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -36420,7 +36420,7 @@ func (p projLEDecimalFloat64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -36528,7 +36528,7 @@ func (p projLEDecimalDecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -36642,7 +36642,7 @@ func (p projLEInt16Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -36778,7 +36778,7 @@ func (p projLEInt16Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -36914,7 +36914,7 @@ func (p projLEInt16Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -37066,7 +37066,7 @@ func (p projLEInt16Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -37208,7 +37208,7 @@ func (p projLEInt16DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -37334,7 +37334,7 @@ func (p projLEInt32Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -37470,7 +37470,7 @@ func (p projLEInt32Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -37606,7 +37606,7 @@ func (p projLEInt32Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -37758,7 +37758,7 @@ func (p projLEInt32Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -37900,7 +37900,7 @@ func (p projLEInt32DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -38026,7 +38026,7 @@ func (p projLEInt64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -38162,7 +38162,7 @@ func (p projLEInt64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -38298,7 +38298,7 @@ func (p projLEInt64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -38450,7 +38450,7 @@ func (p projLEInt64Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -38592,7 +38592,7 @@ func (p projLEInt64DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -38734,7 +38734,7 @@ func (p projLEFloat64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -38902,7 +38902,7 @@ func (p projLEFloat64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -39070,7 +39070,7 @@ func (p projLEFloat64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -39238,7 +39238,7 @@ func (p projLEFloat64Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -39384,7 +39384,7 @@ func (p projLEFloat64DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -39506,7 +39506,7 @@ func (p projLETimestampTimestampConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -39612,7 +39612,7 @@ func (p projLEIntervalIntervalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -39715,7 +39715,7 @@ func (p projLEJSONJSONConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -39821,7 +39821,7 @@ func (p projLEDatumDatumConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -39932,7 +39932,7 @@ func (p projGTBoolBoolConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -40039,7 +40039,7 @@ func (p projGTBytesBytesConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -40142,7 +40142,7 @@ func (p projGTDecimalInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -40258,7 +40258,7 @@ func (p projGTDecimalInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -40374,7 +40374,7 @@ func (p projGTDecimalInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -40494,7 +40494,7 @@ func (p projGTDecimalFloat64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -40602,7 +40602,7 @@ func (p projGTDecimalDecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -40716,7 +40716,7 @@ func (p projGTInt16Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -40852,7 +40852,7 @@ func (p projGTInt16Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -40988,7 +40988,7 @@ func (p projGTInt16Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -41140,7 +41140,7 @@ func (p projGTInt16Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -41282,7 +41282,7 @@ func (p projGTInt16DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -41408,7 +41408,7 @@ func (p projGTInt32Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -41544,7 +41544,7 @@ func (p projGTInt32Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -41680,7 +41680,7 @@ func (p projGTInt32Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -41832,7 +41832,7 @@ func (p projGTInt32Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -41974,7 +41974,7 @@ func (p projGTInt32DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -42100,7 +42100,7 @@ func (p projGTInt64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -42236,7 +42236,7 @@ func (p projGTInt64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -42372,7 +42372,7 @@ func (p projGTInt64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -42524,7 +42524,7 @@ func (p projGTInt64Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -42666,7 +42666,7 @@ func (p projGTInt64DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -42808,7 +42808,7 @@ func (p projGTFloat64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -42976,7 +42976,7 @@ func (p projGTFloat64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -43144,7 +43144,7 @@ func (p projGTFloat64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -43312,7 +43312,7 @@ func (p projGTFloat64Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -43458,7 +43458,7 @@ func (p projGTFloat64DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -43580,7 +43580,7 @@ func (p projGTTimestampTimestampConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -43686,7 +43686,7 @@ func (p projGTIntervalIntervalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -43789,7 +43789,7 @@ func (p projGTJSONJSONConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -43895,7 +43895,7 @@ func (p projGTDatumDatumConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -44006,7 +44006,7 @@ func (p projGEBoolBoolConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -44113,7 +44113,7 @@ func (p projGEBytesBytesConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -44216,7 +44216,7 @@ func (p projGEDecimalInt16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -44332,7 +44332,7 @@ func (p projGEDecimalInt32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -44448,7 +44448,7 @@ func (p projGEDecimalInt64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -44568,7 +44568,7 @@ func (p projGEDecimalFloat64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -44676,7 +44676,7 @@ func (p projGEDecimalDecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -44790,7 +44790,7 @@ func (p projGEInt16Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -44926,7 +44926,7 @@ func (p projGEInt16Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -45062,7 +45062,7 @@ func (p projGEInt16Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -45214,7 +45214,7 @@ func (p projGEInt16Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -45356,7 +45356,7 @@ func (p projGEInt16DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -45482,7 +45482,7 @@ func (p projGEInt32Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -45618,7 +45618,7 @@ func (p projGEInt32Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -45754,7 +45754,7 @@ func (p projGEInt32Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -45906,7 +45906,7 @@ func (p projGEInt32Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -46048,7 +46048,7 @@ func (p projGEInt32DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -46174,7 +46174,7 @@ func (p projGEInt64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -46310,7 +46310,7 @@ func (p projGEInt64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -46446,7 +46446,7 @@ func (p projGEInt64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -46598,7 +46598,7 @@ func (p projGEInt64Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -46740,7 +46740,7 @@ func (p projGEInt64DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -46882,7 +46882,7 @@ func (p projGEFloat64Int16ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -47050,7 +47050,7 @@ func (p projGEFloat64Int32ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -47218,7 +47218,7 @@ func (p projGEFloat64Int64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -47386,7 +47386,7 @@ func (p projGEFloat64Float64ConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -47532,7 +47532,7 @@ func (p projGEFloat64DecimalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -47654,7 +47654,7 @@ func (p projGETimestampTimestampConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -47760,7 +47760,7 @@ func (p projGEIntervalIntervalConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -47863,7 +47863,7 @@ func (p projGEJSONJSONConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -47969,7 +47969,7 @@ func (p projGEDatumDatumConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -72,7 +72,7 @@ func (p projPrefixBytesBytesConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -154,7 +154,7 @@ func (p projSuffixBytesBytesConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -236,7 +236,7 @@ func (p projContainsBytesBytesConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -342,7 +342,7 @@ func (p projSkeletonBytesBytesConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
@@ -440,7 +440,7 @@ func (p projRegexpBytesBytesConstOp) Next() coldata.Batch {
 					}
 				}
 			}
-			projVec.SetNulls(_outNulls.Or(*colNulls))
+			*_outNulls = _outNulls.Or(*colNulls)
 		} else {
 			if sel := batch.Selection(); sel != nil {
 				sel = sel[:n]
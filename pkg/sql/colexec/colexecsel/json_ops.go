@@ -0,0 +1,102 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package colexecsel
+
+import (
+	"github.com/cockroachdb/cockroach/pkg/col/coldata"
+	"github.com/cockroachdb/cockroach/pkg/sql/colexecerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/colexecop"
+	"github.com/cockroachdb/cockroach/pkg/util/json"
+)
+
+// GetJSONContainsOperator returns a selection operator which filters the
+// tuples for which the JSON value in colIdx contains (@>) the constant JSON
+// value, or is contained by it (<@) if the containedBy argument is true.
+func GetJSONContainsOperator(
+	input colexecop.Operator, colIdx int, constArg json.JSON, containedBy bool,
+) colexecop.Operator {
+	return &selJSONContainsConstOp{
+		selConstOpBase: selConstOpBase{
+			OneInputHelper: colexecop.MakeOneInputHelper(input),
+			colIdx:         colIdx,
+		},
+		constArg:    constArg,
+		containedBy: containedBy,
+	}
+}
+
+type selJSONContainsConstOp struct {
+	selConstOpBase
+	constArg    json.JSON
+	containedBy bool
+}
+
+var _ colexecop.Operator = &selJSONContainsConstOp{}
+
+func (p *selJSONContainsConstOp) contains(arg json.JSON) bool {
+	var res bool
+	var err error
+	if p.containedBy {
+		res, err = json.Contains(p.constArg, arg)
+	} else {
+		res, err = json.Contains(arg, p.constArg)
+	}
+	if err != nil {
+		colexecerror.ExpectedError(err)
+	}
+	return res
+}
+
+func (p *selJSONContainsConstOp) Next() coldata.Batch {
+	for {
+		batch := p.Input.Next()
+		n := batch.Length()
+		if n == 0 {
+			return batch
+		}
+
+		vec := batch.ColVec(p.colIdx)
+		col := vec.JSON()
+		var nulls *coldata.Nulls
+		if vec.MaybeHasNulls() {
+			nulls = vec.Nulls()
+		}
+		var idx int
+		if sel := batch.Selection(); sel != nil {
+			sel = sel[:n]
+			for _, i := range sel {
+				if nulls != nil && nulls.NullAt(i) {
+					continue
+				}
+				if p.contains(col.Get(i)) {
+					sel[idx] = i
+					idx++
+				}
+			}
+		} else {
+			batch.SetSelection(true)
+			sel := batch.Selection()
+			for i := 0; i < n; i++ {
+				if nulls != nil && nulls.NullAt(i) {
+					continue
+				}
+				if p.contains(col.Get(i)) {
+					sel[idx] = i
+					idx++
+				}
+			}
+		}
+		if idx > 0 {
+			batch.SetLength(idx)
+			return batch
+		}
+	}
+}
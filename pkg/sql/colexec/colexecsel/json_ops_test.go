@@ -0,0 +1,63 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package colexecsel
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/colexec/colexectestutils"
+	"github.com/cockroachdb/cockroach/pkg/sql/colexecop"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/json"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+)
+
+func TestJSONContainsOperator(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+	tups := colexectestutils.Tuples{
+		{`{"a": 1, "b": [1, 2]}`},
+		{`{"a": 2}`},
+		{`[1, 2, 3]`},
+		{`{}`},
+		{nil},
+	}
+	for _, tc := range []struct {
+		constArg    string
+		containedBy bool
+		expected    colexectestutils.Tuples
+	}{
+		{constArg: `{"a": 1}`, expected: colexectestutils.Tuples{{`{"a": 1, "b": [1, 2]}`}}},
+		{constArg: `{"b": [2]}`, expected: colexectestutils.Tuples{{`{"a": 1, "b": [1, 2]}`}}},
+		{constArg: `[3]`, expected: colexectestutils.Tuples{{`[1, 2, 3]`}}},
+		{
+			constArg: `{}`,
+			expected: colexectestutils.Tuples{{`{"a": 1, "b": [1, 2]}`}, {`{"a": 2}`}, {`{}`}},
+		},
+		{
+			constArg:    `{"a": 2, "c": 3}`,
+			containedBy: true,
+			expected:    colexectestutils.Tuples{{`{"a": 2}`}, {`{}`}},
+		},
+	} {
+		constArg, err := json.ParseJSON(tc.constArg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		colexectestutils.RunTestsWithTyps(
+			t, testAllocator, []colexectestutils.Tuples{tups}, [][]*types.T{{types.Jsonb}},
+			tc.expected, colexectestutils.OrderedVerifier,
+			func(input []colexecop.Operator) (colexecop.Operator, error) {
+				return GetJSONContainsOperator(input[0], 0, constArg, tc.containedBy), nil
+			})
+	}
+}
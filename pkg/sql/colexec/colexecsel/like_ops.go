@@ -106,3 +106,29 @@ func GetLikeOperator(
 		return nil, errors.AssertionFailedf("unsupported like op type %d", likeOpType)
 	}
 }
+
+// GetRegexpOperator returns a selection operator which matches the values
+// against the specified regular expression (the ~ and ~* operators), or which
+// selects the values that don't match if the negate argument is true (the !~
+// and !~* operators). The pattern is compiled only once.
+func GetRegexpOperator(
+	ctx *eval.Context,
+	input colexecop.Operator,
+	colIdx int,
+	pattern string,
+	negate bool,
+	caseInsensitive bool,
+) (colexecop.Operator, error) {
+	re, err := eval.ConvertToRegexp(ctx, pattern, caseInsensitive)
+	if err != nil {
+		return nil, err
+	}
+	return &selRegexpBytesBytesConstOp{
+		selConstOpBase: selConstOpBase{
+			OneInputHelper: colexecop.MakeOneInputHelper(input),
+			colIdx:         colIdx,
+		},
+		constArg: re,
+		negate:   negate,
+	}, nil
+}
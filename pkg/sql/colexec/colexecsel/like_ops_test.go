@@ -180,6 +180,86 @@ func TestLikeOperators(t *testing.T) {
 	}
 }
 
+func TestRegexpOperators(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+	tups := colexectestutils.Tuples{{"abc"}, {"ABD"}, {"xyz"}, {nil}}
+	for _, tc := range []struct {
+		pattern         string
+		negate          bool
+		caseInsensitive bool
+		expected        colexectestutils.Tuples
+	}{
+		{pattern: "^ab", expected: colexectestutils.Tuples{{"abc"}}},
+		{pattern: "^ab", negate: true, expected: colexectestutils.Tuples{{"ABD"}, {"xyz"}}},
+		{pattern: "^ab", caseInsensitive: true, expected: colexectestutils.Tuples{{"abc"}, {"ABD"}}},
+		{pattern: "^ab", negate: true, caseInsensitive: true, expected: colexectestutils.Tuples{{"xyz"}}},
+		{pattern: "(c|z)$", expected: colexectestutils.Tuples{{"abc"}, {"xyz"}}},
+		{pattern: "", expected: colexectestutils.Tuples{{"abc"}, {"ABD"}, {"xyz"}}},
+	} {
+		colexectestutils.RunTests(
+			t, testAllocator, []colexectestutils.Tuples{tups}, tc.expected, colexectestutils.OrderedVerifier,
+			func(input []colexecop.Operator) (colexecop.Operator, error) {
+				ctx := eval.MakeTestingEvalContext(cluster.MakeTestingClusterSettings())
+				return GetRegexpOperator(&ctx, input[0], 0, tc.pattern, tc.negate, tc.caseInsensitive)
+			})
+	}
+
+	ctx := eval.MakeTestingEvalContext(cluster.MakeTestingClusterSettings())
+	if _, err := GetRegexpOperator(&ctx, nil /* input */, 0, "(", false, false); err == nil {
+		t.Fatal("expected an error for an invalid regular expression")
+	}
+}
+
+func BenchmarkRegexpOps(b *testing.B) {
+	defer log.Scope(b).Close(b)
+	rng, _ := randutil.NewTestRand()
+	ctx := context.Background()
+	evalCtx := eval.MakeTestingEvalContext(cluster.MakeTestingClusterSettings())
+
+	typs := []*types.T{types.Bytes}
+	batch := testAllocator.NewMemBatchWithMaxCapacity(typs)
+	col := batch.ColVec(0).Bytes()
+	width := 64
+	for i := 0; i < coldata.BatchSize(); i++ {
+		col.Set(i, randutil.RandBytes(rng, width))
+	}
+	// Set a known prefix on half the batch so we're not filtering everything
+	// out.
+	prefix := "abc"
+	for i := 0; i < coldata.BatchSize()/2; i++ {
+		copy(col.Get(i)[:3], prefix)
+	}
+	batch.SetLength(coldata.BatchSize())
+
+	for _, tc := range []struct {
+		name            string
+		pattern         string
+		caseInsensitive bool
+	}{
+		{name: "prefix", pattern: "^" + prefix},
+		{name: "prefixCaseInsensitive", pattern: "^" + prefix, caseInsensitive: true},
+		{name: "alternation", pattern: "(abc|xyz|lmn)"},
+		{name: "charClass", pattern: "[0-9]{3}"},
+	} {
+		for _, negate := range []bool{false, true} {
+			b.Run(fmt.Sprintf("%s/negate=%t", tc.name, negate), func(b *testing.B) {
+				source := colexecop.NewRepeatableBatchSource(testAllocator, batch, typs)
+				op, err := GetRegexpOperator(&evalCtx, source, 0, tc.pattern, negate, tc.caseInsensitive)
+				if err != nil {
+					b.Fatal(err)
+				}
+				op.Init(ctx)
+				b.SetBytes(int64(width * coldata.BatchSize()))
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					op.Next()
+				}
+			})
+		}
+	}
+}
+
 func BenchmarkLikeOps(b *testing.B) {
 	defer log.Scope(b).Close(b)
 	rng, _ := randutil.NewTestRand()
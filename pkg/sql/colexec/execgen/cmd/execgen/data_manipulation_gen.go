@@ -79,3 +79,23 @@ func replaceManipulationFuncsAmbiguous(overloadField string, body string) string
 	}
 	return body
 }
+
+var propagateNullsRe = regexp.MustCompile(`execgen\.PROPAGATENULLS\((.*)\)`)
+
+// replacePropagateNulls replaces execgen.PROPAGATENULLS template placeholders
+// with the code that unions the nulls of all the sources into the
+// destination. Unlike the other data manipulation functions, the generated
+// code doesn't depend on the overload, so the cursor of the template can be
+// pointing anywhere.
+func replacePropagateNulls(body string) string {
+	return propagateNullsRe.ReplaceAllStringFunc(body, func(call string) string {
+		args := strings.Split(propagateNullsRe.FindStringSubmatch(call)[1], ",")
+		dest := strings.TrimSpace(args[0])
+		var b strings.Builder
+		fmt.Fprintf(&b, "*%[1]s = %[1]s", dest)
+		for _, src := range args[1:] {
+			fmt.Fprintf(&b, ".Or(*%s)", strings.TrimSpace(src))
+		}
+		return b.String()
+	})
+}
@@ -81,6 +81,13 @@ func (g *execgenTool) run(args ...string) bool {
 
 	// Get remaining args after any flags have been parsed.
 	args = g.cmdLine.Args()
+	if len(args) > 0 && args[0] == "vet" {
+		if template != "" {
+			g.reportError(errors.New("-template cannot be used with vet"))
+			return false
+		}
+		return g.vet(args[1:])
+	}
 	if len(args) != 1 {
 		g.cmdLine.Usage()
 		g.reportError(errors.New("invalid number of arguments"))
@@ -113,6 +120,19 @@ var emptyCommentRegex = regexp.MustCompile(`[ \t]*//[ \t]*\n`)
 var emptyBlockCommentRegex = regexp.MustCompile(`[ \t]*/\*[ \t]*\*/[ \t]*\n`)
 
 func (g *execgenTool) generate(path string, entry entry) error {
+	b, err := g.expand(path, entry)
+	// Ignore any write error if another error already occurred.
+	_, writeErr := os.Stdout.Write(b)
+	if err != nil {
+		return err
+	}
+	return writeErr
+}
+
+// expand runs the generator for the file at the given path and returns the
+// generated code. If formatting of the generated code fails, the unformatted
+// code is returned alongside the error, for easier debugging.
+func (g *execgenTool) expand(path string, entry entry) ([]byte, error) {
 	var buf bytes.Buffer
 	buf.WriteString("// Code generated by execgen; DO NOT EDIT.\n")
 
@@ -121,13 +141,13 @@ func (g *execgenTool) generate(path string, entry entry) error {
 	if entry.inputFile != "" {
 		inputFileBytes, err := os.ReadFile(entry.inputFile)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		// Delete execgen_template build tag.
 		inputFileBytes = bytes.ReplaceAll(inputFileBytes, []byte("// +build execgen_template"), []byte{})
 		inputFileContents, err = execgen.Generate(string(inputFileBytes))
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if g.verbose {
 			fmt.Fprintln(os.Stderr, "generated code before text/template runs")
@@ -139,7 +159,7 @@ func (g *execgenTool) generate(path string, entry entry) error {
 
 	err = entry.fn(inputFileContents, &buf)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	b := buf.Bytes()
@@ -160,13 +180,7 @@ func (g *execgenTool) generate(path string, entry entry) error {
 			err = errors.Wrap(err, "Code formatting failed with Go parse error")
 		}
 	}
-
-	// Ignore any write error if another error already occurred.
-	_, writeErr := os.Stdout.Write(b)
-	if err != nil {
-		return err
-	}
-	return writeErr
+	return b, err
 }
 
 // usage is a replacement usage function for the flags package.
@@ -175,7 +189,10 @@ func (g *execgenTool) usage() {
 	fmt.Fprintf(g.stdErr, "columnarized execution.\n\n")
 
 	fmt.Fprintf(g.stdErr, "Usage:\n")
-	fmt.Fprintf(g.stdErr, "\texecgen [path]...\n\n")
+	fmt.Fprintf(g.stdErr, "\texecgen [path]...\n")
+	fmt.Fprintf(g.stdErr, "\texecgen vet [path]...\n\n")
+	fmt.Fprintf(g.stdErr, "The vet command generates the given files without writing them and ")
+	fmt.Fprintf(g.stdErr, "type-checks their packages with the generated code in place.\n\n")
 
 	fmt.Fprintf(g.stdErr, "Supported filenames are:\n")
 	for filename := range generators {
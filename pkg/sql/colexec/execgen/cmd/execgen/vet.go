@@ -0,0 +1,86 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/tools/go/packages"
+)
+
+// vet generates the files at the given paths without writing them, and then
+// type-checks the packages containing those files with the generated code in
+// place of the files on disk. This catches template breakage (for example, a
+// type combination that expands into code that doesn't compile) without having
+// to regenerate the files and run the full build. It returns whether all the
+// files were generated and type-checked successfully.
+func (g *execgenTool) vet(paths []string) bool {
+	if len(paths) == 0 {
+		g.cmdLine.Usage()
+		g.reportError(errors.New("no files to vet"))
+		return false
+	}
+
+	ok := true
+	overlay := make(map[string][]byte, len(paths))
+	dirs := make(map[string]struct{})
+	for _, path := range paths {
+		_, file := filepath.Split(path)
+		e := generators[file]
+		if e.fn == nil {
+			g.reportError(errors.Errorf("unrecognized filename: %s", file))
+			ok = false
+			continue
+		}
+		b, err := g.expand(path, e)
+		if err != nil {
+			g.reportError(errors.Wrapf(err, "generating %s", path))
+			ok = false
+			continue
+		}
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			g.reportError(err)
+			return false
+		}
+		overlay[absPath] = b
+		dirs[filepath.Dir(absPath)] = struct{}{}
+	}
+	if !ok {
+		return false
+	}
+
+	patterns := make([]string, 0, len(dirs))
+	for dir := range dirs {
+		patterns = append(patterns, dir)
+	}
+	sort.Strings(patterns)
+	pkgs, err := packages.Load(&packages.Config{
+		Mode:    packages.NeedName | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo,
+		Overlay: overlay,
+	}, patterns...)
+	if err != nil {
+		g.reportError(err)
+		return false
+	}
+	// Only report the errors in the packages containing the generated files;
+	// errors in their dependencies are not caused by the templates.
+	for _, pkg := range pkgs {
+		for _, err := range pkg.Errors {
+			fmt.Fprintf(g.stdErr, "%s: %v\n", pkg.PkgPath, err)
+			ok = false
+		}
+	}
+	return ok
+}
@@ -23,6 +23,7 @@ var (
 	_ = APPENDSLICE
 	_ = APPENDVAL
 	_ = SETVARIABLESIZE
+	_ = PROPAGATENULLS
 )
 
 // COPYVAL is a template function that can be used to set a scalar to the value
@@ -32,12 +33,20 @@ func COPYVAL(dest, src interface{}) {
 	colexecerror.InternalError(errors.AssertionFailedf(nonTemplatePanic))
 }
 
-// APPENDSLICE is a template function.
+// APPENDSLICE is a template function that appends the elements in range
+// [srcStartIdx, srcEndIdx) of src to target starting at destIdx.
+//
+// The generated code does not perform any memory accounting on its own. It is
+// only used by coldata.Vec.Append whose sole caller in the vectorized engine is
+// colexecutils.AppendOnlyBufferedBatch.AppendTuples, and the latter registers
+// any growth of the vectors with the operator's allocator via
+// colmem.Allocator.PerformAppend. New usages must be wrapped similarly.
 func APPENDSLICE(target, src, destIdx, srcStartIdx, srcEndIdx interface{}) {
 	colexecerror.InternalError(errors.AssertionFailedf(nonTemplatePanic))
 }
 
-// APPENDVAL is a template function.
+// APPENDVAL is a template function that appends v to target. Same as with
+// APPENDSLICE, the caller is responsible for the memory accounting.
 func APPENDVAL(target, v interface{}) {
 	colexecerror.InternalError(errors.AssertionFailedf(nonTemplatePanic))
 }
@@ -47,3 +56,11 @@ func SETVARIABLESIZE(target, value interface{}) interface{} {
 	colexecerror.InternalError(errors.AssertionFailedf(nonTemplatePanic))
 	return nil
 }
+
+// PROPAGATENULLS is a template function that unions the nulls of the source
+// vectors into the nulls of the output vector. dest must be the *coldata.Nulls
+// returned by Nulls() of the output vector, and srcs are *coldata.Nulls of the
+// inputs.
+func PROPAGATENULLS(dest interface{}, srcs ...interface{}) {
+	colexecerror.InternalError(errors.AssertionFailedf(nonTemplatePanic))
+}
@@ -105,9 +105,45 @@ func (i *invariantsChecker) Next() coldata.Batch {
 			}
 		}
 	}
+	for colIdx, vec := range b.ColVecs() {
+		checkNullsConsistency(vec.Nulls(), n, b.Selection(), colIdx)
+	}
 	return b
 }
 
+// checkNullsConsistency asserts that the null bitmap of the vector is large
+// enough to contain all n tuples of the batch and that no tuple is marked as
+// NULL when the vector claims that it has no nulls. Violating either of these
+// wouldn't necessarily result in a panic, so the corruption could go unnoticed
+// otherwise.
+func checkNullsConsistency(nulls *coldata.Nulls, n int, sel []int, colIdx int) {
+	maxIdx := n - 1
+	if sel != nil {
+		maxIdx = sel[n-1]
+	}
+	if bitmapLen := len(nulls.NullBitmap()) * 8; maxIdx >= bitmapLen {
+		colexecerror.InternalError(errors.AssertionFailedf(
+			"null bitmap of vector %d can hold %d values but the batch refers "+
+				"to index %d", colIdx, bitmapLen, maxIdx,
+		))
+	}
+	if nulls.MaybeHasNulls() {
+		return
+	}
+	for i := 0; i < n; i++ {
+		rowIdx := i
+		if sel != nil {
+			rowIdx = sel[i]
+		}
+		if nulls.NullAt(rowIdx) {
+			colexecerror.InternalError(errors.AssertionFailedf(
+				"vector %d claims to have no nulls but the value at index %d "+
+					"is NULL", colIdx, rowIdx,
+			))
+		}
+	}
+}
+
 // DrainMeta implements the colexecop.MetadataSource interface.
 func (i *invariantsChecker) DrainMeta() []execinfrapb.ProducerMetadata {
 	if shortCircuit := i.assertInitWasCalled(); shortCircuit {
@@ -0,0 +1,99 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package colexec
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/col/coldata"
+	"github.com/cockroachdb/cockroach/pkg/sql/colexecerror"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckNullsConsistency(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	withNull := func(len, idx int) *coldata.Nulls {
+		n := coldata.NewNulls(len)
+		n.SetNull(idx)
+		return &n
+	}
+	noNulls := func(len int) *coldata.Nulls {
+		n := coldata.NewNulls(len)
+		return &n
+	}
+	allNulls := func(len int) *coldata.Nulls {
+		n := coldata.NewNulls(len)
+		n.SetNulls()
+		return &n
+	}
+	// corrupt returns a bitmap marking idx as NULL while claiming to have no
+	// nulls. The bitmap uses 0 for NULL.
+	corrupt := func(len, idx int) *coldata.Nulls {
+		n := coldata.NewNulls(len)
+		n.NullBitmap()[idx/8] &^= 1 << (idx % 8)
+		return &n
+	}
+	window := func(n *coldata.Nulls, start, end int) *coldata.Nulls {
+		w := n.Slice(start, end)
+		return &w
+	}
+
+	for _, tc := range []struct {
+		desc        string
+		nulls       *coldata.Nulls
+		n           int
+		sel         []int
+		expectedErr string
+	}{
+		{desc: "no nulls", nulls: noNulls(16), n: 16},
+		{desc: "nulls", nulls: withNull(16, 3), n: 16},
+		{desc: "selection", nulls: noNulls(16), n: 3, sel: []int{1, 5, 15}},
+		{desc: "selection with nulls", nulls: withNull(16, 5), n: 3, sel: []int{1, 5, 15}},
+		{desc: "windowed", nulls: window(withNull(16, 3), 2, 6), n: 4},
+		{desc: "windowed without nulls", nulls: window(noNulls(16), 2, 6), n: 4},
+		{
+			// Columns that are not needed by the fetcher are filled with nulls.
+			desc: "unneeded column", nulls: allNulls(16), n: 16,
+		},
+		{
+			desc: "null without nulls", nulls: corrupt(16, 3), n: 16,
+			expectedErr: "vector 0 claims to have no nulls but the value at index 3 is NULL",
+		},
+		{
+			desc: "selected null without nulls", nulls: corrupt(16, 5), n: 3, sel: []int{1, 5, 15},
+			expectedErr: "vector 0 claims to have no nulls but the value at index 5 is NULL",
+		},
+		{
+			desc: "short bitmap", nulls: noNulls(8), n: 9,
+			expectedErr: "null bitmap of vector 0 can hold 8 values but the batch refers to index 8",
+		},
+		{
+			desc: "short bitmap with selection", nulls: withNull(8, 1), n: 2, sel: []int{1, 9},
+			expectedErr: "null bitmap of vector 0 can hold 8 values but the batch refers to index 9",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := colexecerror.CatchVectorizedRuntimeError(func() {
+				checkNullsConsistency(tc.nulls, tc.n, tc.sel, 0 /* colIdx */)
+			})
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expectedErr)
+		})
+	}
+}
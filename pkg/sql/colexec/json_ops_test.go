@@ -0,0 +1,113 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package colexec
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
+	"github.com/cockroachdb/cockroach/pkg/sql/colexec/colexectestutils"
+	"github.com/cockroachdb/cockroach/pkg/sql/colexecop"
+	"github.com/cockroachdb/cockroach/pkg/sql/execinfra"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/eval"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+)
+
+func TestJSONContainsProjOp(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+	ctx := context.Background()
+	st := cluster.MakeTestingClusterSettings()
+	evalCtx := eval.MakeTestingEvalContext(st)
+	defer evalCtx.Stop(ctx)
+	flowCtx := &execinfra.FlowCtx{
+		EvalCtx: &evalCtx,
+		Cfg: &execinfra.ServerConfig{
+			Settings: st,
+		},
+	}
+
+	testCases := []struct {
+		desc         string
+		inputTypes   []*types.T
+		inputTuples  colexectestutils.Tuples
+		outputTuples colexectestutils.Tuples
+		projExpr     string
+	}{
+		{
+			desc:       "SELECT c, c @> '{\"a\": 1}' FROM t",
+			inputTypes: []*types.T{types.Jsonb},
+			inputTuples: colexectestutils.Tuples{
+				{`{"a": 1, "b": 2}`}, {`{"a": 2}`}, {nil}, {`[1, 2]`},
+			},
+			outputTuples: colexectestutils.Tuples{
+				{`{"a": 1, "b": 2}`, true}, {`{"a": 2}`, false}, {nil, nil}, {`[1, 2]`, false},
+			},
+			projExpr: `@1 @> '{"a": 1}'`,
+		},
+		{
+			desc:       "SELECT c, c <@ '{\"a\": 1, \"b\": 2}' FROM t",
+			inputTypes: []*types.T{types.Jsonb},
+			inputTuples: colexectestutils.Tuples{
+				{`{"a": 1}`}, {nil}, {`{"a": 1, "c": 3}`}, {`{}`},
+			},
+			outputTuples: colexectestutils.Tuples{
+				{`{"a": 1}`, true}, {nil, nil}, {`{"a": 1, "c": 3}`, false}, {`{}`, true},
+			},
+			projExpr: `@1 <@ '{"a": 1, "b": 2}'`,
+		},
+		{
+			desc:       "SELECT c, c @> NULL FROM t",
+			inputTypes: []*types.T{types.Jsonb},
+			inputTuples: colexectestutils.Tuples{
+				{`{"a": 1}`}, {nil},
+			},
+			outputTuples: colexectestutils.Tuples{
+				{`{"a": 1}`, nil}, {nil, nil},
+			},
+			projExpr: `@1 @> NULL::JSONB`,
+		},
+		{
+			// The right argument isn't a constant, so the default comparison
+			// operator is planned instead.
+			desc:       "SELECT a, b, a @> b FROM t",
+			inputTypes: []*types.T{types.Jsonb, types.Jsonb},
+			inputTuples: colexectestutils.Tuples{
+				{`{"a": 1, "b": 2}`, `{"b": 2}`},
+				{`{"a": 1}`, `{"b": 2}`},
+				{nil, `{}`},
+				{`{}`, nil},
+			},
+			outputTuples: colexectestutils.Tuples{
+				{`{"a": 1, "b": 2}`, `{"b": 2}`, true},
+				{`{"a": 1}`, `{"b": 2}`, false},
+				{nil, `{}`, nil},
+				{`{}`, nil, nil},
+			},
+			projExpr: `@1 @> @2`,
+		},
+	}
+
+	for _, c := range testCases {
+		log.Infof(ctx, "%s", c.desc)
+		colexectestutils.RunTestsWithTyps(
+			t, testAllocator, []colexectestutils.Tuples{c.inputTuples}, [][]*types.T{c.inputTypes},
+			c.outputTuples, colexectestutils.OrderedVerifier,
+			func(input []colexecop.Operator) (colexecop.Operator, error) {
+				return colexectestutils.CreateTestProjectingOperator(
+					ctx, flowCtx, input[0], c.inputTypes, c.projExpr, testMemAcc,
+				)
+			})
+	}
+}
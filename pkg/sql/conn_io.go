@@ -575,6 +575,14 @@ func (buf *StmtBuf) Rewind(ctx context.Context, pos CmdPos) {
 	buf.mu.curPos = pos
 }
 
+// LastPos returns the position of the last command pushed into the buffer, or
+// -1 if no command has been pushed yet.
+func (buf *StmtBuf) LastPos() CmdPos {
+	buf.mu.Lock()
+	defer buf.mu.Unlock()
+	return buf.mu.lastPos
+}
+
 // Len returns the buffer's length.
 func (buf *StmtBuf) Len() int {
 	buf.mu.Lock()
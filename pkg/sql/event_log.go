@@ -200,6 +200,7 @@ func (p *planner) getCommonSQLEventDetails(opt redactionOptions) eventpb.CommonS
 			commonSQLEventDetails.PlaceholderValues[idx] = val.String()
 		}
 	}
+	commonSQLEventDetails.TruncateStatement(int(eventLogMaxStatementLength.Get(&p.execCfg.Settings.SV)))
 	return commonSQLEventDetails
 }
 
@@ -423,6 +424,17 @@ var eventLogSystemTableEnabled = settings.RegisterBoolSetting(
 	true,
 ).WithPublic()
 
+// eventLogMaxStatementLength limits the size of the statement string
+// included in SQL events.
+var eventLogMaxStatementLength = settings.RegisterByteSizeSetting(
+	settings.TenantWritable,
+	"sql.log.max_statement_length",
+	"if positive, the statement strings included in logged SQL events are "+
+		"truncated to this size; truncated events are marked as such",
+	0,
+	settings.NonNegativeInt,
+)
+
 // EventLogTestingKnobs provides hooks and knobs for event logging.
 type EventLogTestingKnobs struct {
 	// SyncWrites causes events to be written on the same txn as
@@ -511,6 +523,10 @@ func insertEventRecords(
 		event := entries[i]
 		eventType := logpb.GetEventTypeName(event)
 		event.CommonDetails().EventType = eventType
+		// Capture the trace and tenant details now, since the events may be
+		// emitted later under a different context.
+		log.AnnotateEventWithTrace(ctx, event)
+		log.AnnotateEventWithTenant(ctx, event)
 
 		// The caller is responsible for the timestamp field.
 		if event.CommonDetails().Timestamp == 0 {
@@ -15,8 +15,11 @@ import (
 	"context"
 	"crypto/hmac"
 	"crypto/sha256"
+	"database/sql/driver"
 	"encoding/hex"
+	gojson "encoding/json"
 	"fmt"
+	"math/big"
 	"net"
 	"net/url"
 	"reflect"
@@ -101,6 +104,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/util/duration"
 	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
 	"github.com/cockroachdb/cockroach/pkg/util/hlc"
+	"github.com/cockroachdb/cockroach/pkg/util/json"
 	"github.com/cockroachdb/cockroach/pkg/util/log"
 	"github.com/cockroachdb/cockroach/pkg/util/metric"
 	"github.com/cockroachdb/cockroach/pkg/util/mon"
@@ -1705,85 +1709,247 @@ func getPlanDistribution(
 func golangFillQueryArguments(args ...interface{}) (tree.Datums, error) {
 	res := make(tree.Datums, len(args))
 	for i, arg := range args {
-		if arg == nil {
-			res[i] = tree.DNull
-			continue
+		d, err := golangToDatum(arg)
+		if err != nil {
+			return nil, err
+		}
+		if d == nil {
+			return nil, pgerror.Newf(pgcode.InvalidParameterValue,
+				"unsupported type %T for argument %d", arg, i+1)
 		}
+		res[i] = d
+	}
+	return res, nil
+}
 
-		// A type switch to handle a few explicit types with special semantics:
-		// - Datums are passed along as is.
-		// - Time datatypes get special representation in the database.
-		// - Usernames are assumed pre-normalized for lookup and validation.
-		var d tree.Datum
-		switch t := arg.(type) {
-		case tree.Datum:
-			d = t
-		case time.Time:
+// golangToDatum transforms a Go value into a datum. It returns a nil datum if
+// the type of the value is not supported.
+func golangToDatum(arg interface{}) (tree.Datum, error) {
+	if arg == nil {
+		return tree.DNull, nil
+	}
+
+	// A type switch to handle a few explicit types with special semantics:
+	// - Datums are passed along as is.
+	// - DatumProviders are converted by themselves.
+	// - Time datatypes get special representation in the database.
+	// - Usernames are assumed pre-normalized for lookup and validation.
+	// - Rationals and third-party decimals are passed as DECIMAL.
+	// - UUIDs (and 16-byte arrays) are passed as UUIDs rather than strings,
+	//   so that they can be compared against UUID columns directly.
+	// - IP addresses and networks are passed as INET rather than BYTES.
+	// - Raw JSON messages and JSON objects decoded into maps are passed as
+	//   JSONB.
+	// - driver.Valuers (e.g. sql.NullString) are passed as the value they
+	//   convert to, which might be NULL.
+	var d tree.Datum
+	switch t := arg.(type) {
+	case tree.Datum:
+		d = t
+	case sqlutil.DatumProvider:
+		d = tree.DNull
+		if !isNilPtr(t) {
 			var err error
+			if d, err = t.ToDatum(); err != nil {
+				return nil, err
+			}
+		}
+	case time.Time:
+		// Times in a location other than UTC are converted to TIMESTAMPTZ
+		// so that the zone isn't silently dropped, even if the zone's
+		// offset happens to be zero. Times in UTC (notably the ones
+		// produced by timeutil.Now()) are converted to TIMESTAMP. Callers
+		// that need a TIMESTAMP regardless of the zone can pass t.UTC() or
+		// a *tree.DTimestamp instead.
+		var err error
+		if t.Location() != time.UTC {
+			d, err = tree.MakeDTimestampTZ(t, time.Microsecond)
+		} else {
 			d, err = tree.MakeDTimestamp(t, time.Microsecond)
-			if err != nil {
+		}
+		if err != nil {
+			return nil, err
+		}
+	case time.Duration:
+		d = &tree.DInterval{Duration: duration.MakeDuration(t.Nanoseconds(), 0, 0)}
+	case bitarray.BitArray:
+		d = &tree.DBitArray{BitArray: t}
+	case *apd.Decimal:
+		dd := &tree.DDecimal{}
+		dd.Set(t)
+		d = dd
+	case *big.Rat:
+		d = tree.DNull
+		if t != nil {
+			num := apd.NewWithBigInt(new(apd.BigInt).SetMathBigInt(t.Num()), 0)
+			den := apd.NewWithBigInt(new(apd.BigInt).SetMathBigInt(t.Denom()), 0)
+			dd := &tree.DDecimal{}
+			if _, err := tree.DecimalCtx.Quo(&dd.Decimal, num, den); err != nil {
 				return nil, err
 			}
-		case time.Duration:
-			d = &tree.DInterval{Duration: duration.MakeDuration(t.Nanoseconds(), 0, 0)}
-		case bitarray.BitArray:
-			d = &tree.DBitArray{BitArray: t}
-		case *apd.Decimal:
+			d = dd
+		}
+	case bigDecimal:
+		d = tree.DNull
+		if !isNilPtr(t) {
 			dd := &tree.DDecimal{}
-			dd.Set(t)
+			dd.Set(apd.NewWithBigInt(new(apd.BigInt).SetMathBigInt(t.Coefficient()), t.Exponent()))
 			d = dd
-		case username.SQLUsername:
-			d = tree.NewDString(t.Normalized())
 		}
-		if d == nil {
-			// Handle all types which have an underlying type that can be stored in the
-			// database.
-			// Note: if this reflection becomes a performance concern in the future,
-			// commonly used types could be added explicitly into the type switch above
-			// for a performance gain.
-			val := reflect.ValueOf(arg)
-			switch val.Kind() {
-			case reflect.Bool:
-				d = tree.MakeDBool(tree.DBool(val.Bool()))
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-				d = tree.NewDInt(tree.DInt(val.Int()))
-			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-				d = tree.NewDInt(tree.DInt(val.Uint()))
-			case reflect.Float32, reflect.Float64:
-				d = tree.NewDFloat(tree.DFloat(val.Float()))
-			case reflect.String:
-				d = tree.NewDString(val.String())
-			case reflect.Slice:
-				switch {
-				case val.IsNil():
-					d = tree.DNull
-				case val.Type().Elem().Kind() == reflect.String:
-					a := tree.NewDArray(types.String)
-					for v := 0; v < val.Len(); v++ {
-						if err := a.Append(tree.NewDString(val.Index(v).String())); err != nil {
-							return nil, err
-						}
-					}
-					d = a
-				case val.Type().Elem().Kind() == reflect.Int:
-					a := tree.NewDArray(types.Int)
-					for v := 0; v < val.Len(); v++ {
-						if err := a.Append(tree.NewDInt(tree.DInt(val.Index(v).Int()))); err != nil {
-							return nil, err
-						}
-					}
+	case username.SQLUsername:
+		d = tree.NewDString(t.Normalized())
+	case uuid.UUID:
+		d = tree.NewDUuid(tree.DUuid{UUID: t})
+	case [uuid.Size]byte:
+		d = tree.NewDUuid(tree.DUuid{UUID: t})
+	case uuid.NullUUID:
+		d = tree.DNull
+		if t.Valid {
+			d = tree.NewDUuid(tree.DUuid{UUID: t.UUID})
+		}
+	case net.IP:
+		d = tree.DNull
+		if t != nil {
+			var err error
+			if d, err = tree.ParseDIPAddrFromINetString(t.String()); err != nil {
+				return nil, err
+			}
+		}
+	case *net.IPNet:
+		d = tree.DNull
+		if t != nil {
+			var err error
+			if d, err = tree.ParseDIPAddrFromINetString(t.String()); err != nil {
+				return nil, err
+			}
+		}
+	case gojson.RawMessage:
+		d = tree.DNull
+		if t != nil {
+			var err error
+			if d, err = tree.ParseDJSON(string(t)); err != nil {
+				return nil, err
+			}
+		}
+	case map[string]interface{}:
+		d = tree.DNull
+		if t != nil {
+			j, err := json.MakeJSON(t)
+			if err != nil {
+				return nil, err
+			}
+			d = tree.NewDJSON(j)
+		}
+	case driver.Valuer:
+		// Values that know how to convert themselves into a basic Go type
+		// (e.g. the sql.Null* wrappers) are converted using that type. If that
+		// type isn't supported, the Valuer is reported as unsupported.
+		if isNilPtr(t) {
+			return tree.DNull, nil
+		}
+		v, err := t.Value()
+		if err != nil {
+			return nil, err
+		}
+		return golangToDatum(v)
+	}
+	if d == nil {
+		// Handle all types which have an underlying type that can be stored in the
+		// database.
+		// Note: if this reflection becomes a performance concern in the future,
+		// commonly used types could be added explicitly into the type switch above
+		// for a performance gain.
+		val := reflect.ValueOf(arg)
+		switch val.Kind() {
+		case reflect.Bool:
+			d = tree.MakeDBool(tree.DBool(val.Bool()))
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			d = tree.NewDInt(tree.DInt(val.Int()))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			d = tree.NewDInt(tree.DInt(val.Uint()))
+		case reflect.Float32, reflect.Float64:
+			d = tree.NewDFloat(tree.DFloat(val.Float()))
+		case reflect.String:
+			d = tree.NewDString(val.String())
+		case reflect.Slice:
+			switch {
+			case val.IsNil():
+				d = tree.DNull
+			case val.Type().Elem().Kind() == reflect.Uint8:
+				d = tree.NewDBytes(tree.DBytes(val.Bytes()))
+			default:
+				a, err := golangSliceToDArray(val)
+				if err != nil {
+					return nil, err
+				}
+				if a != nil {
 					d = a
-				case val.Type().Elem().Kind() == reflect.Uint8:
-					d = tree.NewDBytes(tree.DBytes(val.Bytes()))
 				}
 			}
-			if d == nil {
-				panic(errors.AssertionFailedf("unexpected type %T", arg))
-			}
 		}
-		res[i] = d
 	}
-	return res, nil
+	return d, nil
+}
+
+// isNilPtr returns whether v is a nil pointer. A nil pointer to a type whose
+// methods have value receivers, such as *sql.NullString or *decimal.Decimal,
+// still implements the interfaces of those methods, but calling them panics.
+func isNilPtr(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
+
+// bigDecimal is implemented by arbitrary-precision decimal types from
+// third-party libraries (notably github.com/shopspring/decimal) which expose
+// their value as a coefficient and a base-10 exponent. Matching on the methods
+// rather than on the concrete type avoids depending on those libraries.
+type bigDecimal interface {
+	Coefficient() *big.Int
+	Exponent() int32
+}
+
+// golangSliceToDArray converts a slice of booleans, integers, floats or
+// strings into an array datum of the corresponding element type. It returns
+// nil if the element type of the slice is not supported.
+func golangSliceToDArray(val reflect.Value) (*tree.DArray, error) {
+	var typ *types.T
+	var elemToDatum func(reflect.Value) tree.Datum
+	switch val.Type().Elem().Kind() {
+	case reflect.Bool:
+		typ = types.Bool
+		elemToDatum = func(v reflect.Value) tree.Datum {
+			return tree.MakeDBool(tree.DBool(v.Bool()))
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		typ = types.Int
+		elemToDatum = func(v reflect.Value) tree.Datum {
+			return tree.NewDInt(tree.DInt(v.Int()))
+		}
+	case reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		typ = types.Int
+		elemToDatum = func(v reflect.Value) tree.Datum {
+			return tree.NewDInt(tree.DInt(v.Uint()))
+		}
+	case reflect.Float32, reflect.Float64:
+		typ = types.Float
+		elemToDatum = func(v reflect.Value) tree.Datum {
+			return tree.NewDFloat(tree.DFloat(v.Float()))
+		}
+	case reflect.String:
+		typ = types.String
+		elemToDatum = func(v reflect.Value) tree.Datum {
+			return tree.NewDString(v.String())
+		}
+	default:
+		return nil, nil
+	}
+	a := tree.NewDArray(typ)
+	for i := 0; i < val.Len(); i++ {
+		if err := a.Append(elemToDatum(val.Index(i))); err != nil {
+			return nil, err
+		}
+	}
+	return a, nil
 }
 
 // checkResultType verifies that a table result can be returned to the
@@ -123,21 +123,33 @@ func (e *explainPlanNode) startExec(params runParams) error {
 			}
 		}
 
-		if e.options.Flags[tree.ExplainFlagJSON] {
-			// For the JSON flag, we only want to emit the diagram JSON.
+		if e.options.Flags[tree.ExplainFlagJSON] && e.options.Mode == tree.ExplainDistSQL {
+			// For the JSON flag with DISTSQL, we only want to emit the diagram
+			// JSON.
 			rows = []string{diagramJSON}
 		} else {
 			if err := emitExplain(ob, params.EvalContext(), params.p.ExecCfg().Codec, e.plan); err != nil {
 				return err
 			}
-			rows = ob.BuildStringRows()
-			if e.options.Mode == tree.ExplainDistSQL {
-				rows = append(rows, "", fmt.Sprintf("Diagram: %s", diagramURL.String()))
+			if e.options.Flags[tree.ExplainFlagJSON] {
+				// For the JSON flag with PLAN, we emit the plan as a single JSON
+				// document.
+				planJSON, err := ob.BuildJSON()
+				if err != nil {
+					return err
+				}
+				rows = []string{planJSON}
+			} else {
+				rows = ob.BuildStringRows()
+				if e.options.Mode == tree.ExplainDistSQL {
+					rows = append(rows, "", fmt.Sprintf("Diagram: %s", diagramURL.String()))
+				}
 			}
 		}
 	}
-	// Add index recommendations to output, if they exist.
-	if recs := params.p.instrumentation.indexRecs; recs != nil {
+	// Add index recommendations to output, if they exist. The JSON output
+	// consists of a single document, so they are omitted in that case.
+	if recs := params.p.instrumentation.indexRecs; recs != nil && !e.options.Flags[tree.ExplainFlagJSON] {
 		// First add empty row.
 		rows = append(rows, "")
 		rows = append(rows, fmt.Sprintf("index recommendations: %d", len(recs)))
@@ -12,6 +12,7 @@ package explain
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -260,6 +261,67 @@ func (ob *OutputBuilder) BuildProtoTree() *roachpb.ExplainTreePlanNode {
 	return sentinel.Children[0]
 }
 
+// jsonPlan is the JSON representation of the plan information built by
+// BuildJSON.
+type jsonPlan struct {
+	Fields   []jsonAttr `json:"fields,omitempty"`
+	Plan     *jsonNode  `json:"plan,omitempty"`
+	Warnings []string   `json:"warnings,omitempty"`
+}
+
+type jsonNode struct {
+	Name     string      `json:"name"`
+	Columns  string      `json:"columns,omitempty"`
+	Ordering string      `json:"ordering,omitempty"`
+	Attrs    []jsonAttr  `json:"attrs,omitempty"`
+	Children []*jsonNode `json:"children,omitempty"`
+}
+
+// jsonAttr is a field of the plan or of a node. A slice of them is used instead
+// of a map since the same key can be used multiple times, and since the order
+// of the fields is meaningful.
+type jsonAttr struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+}
+
+// BuildJSON creates a JSON representation of the plan information. It contains
+// the same information as the output of BuildStringRows: the top-level fields,
+// the tree of nodes (along with their columns, ordering and fields), and the
+// warnings.
+func (ob *OutputBuilder) BuildJSON() (string, error) {
+	var p jsonPlan
+	// As in BuildProtoTree, stack keeps track of the current node on each level
+	// with a sentinel node for level 0.
+	sentinel := &jsonNode{}
+	stack := []*jsonNode{sentinel}
+	for i := range ob.entries {
+		e := &ob.entries[i]
+		switch {
+		case e.isNode():
+			parent := stack[e.level-1]
+			child := &jsonNode{Name: e.node, Columns: e.columns, Ordering: e.ordering}
+			parent.Children = append(parent.Children, child)
+			stack = append(stack[:e.level], child)
+		case len(sentinel.Children) == 0:
+			// Fields that precede the first node are top-level fields.
+			p.Fields = append(p.Fields, jsonAttr{Key: e.field, Value: e.fieldVal})
+		default:
+			node := stack[len(stack)-1]
+			node.Attrs = append(node.Attrs, jsonAttr{Key: e.field, Value: e.fieldVal})
+		}
+	}
+	if len(sentinel.Children) > 0 {
+		p.Plan = sentinel.Children[0]
+	}
+	p.Warnings = ob.GetWarnings()
+	res, err := json.Marshal(&p)
+	if err != nil {
+		return "", err
+	}
+	return string(res), nil
+}
+
 // AddTopLevelField adds a top-level field. Cannot be called while inside a
 // node.
 func (ob *OutputBuilder) AddTopLevelField(key, value string) {
@@ -96,6 +96,13 @@ func TestOutputBuilder(t *testing.T) {
 			}
 			return string(treeYaml)
 
+		case "json":
+			res, err := ob.BuildJSON()
+			if err != nil {
+				panic(err)
+			}
+			return res
+
 		default:
 			panic(fmt.Sprintf("unknown command %s", d.Cmd))
 		}
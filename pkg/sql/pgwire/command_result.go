@@ -115,6 +115,7 @@ func (r *commandResult) Close(ctx context.Context, t sql.TransactionStatusIndica
 	if r.errExpected && r.err == nil {
 		panic("expected err to be set on result by Close, but wasn't")
 	}
+	r.conn.recordClientMsgLatency(r.pos)
 
 	r.conn.writerState.fi.registerCmd(r.pos)
 	if r.err != nil {
@@ -41,9 +41,11 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/util/log"
 	"github.com/cockroachdb/cockroach/pkg/util/log/eventpb"
 	"github.com/cockroachdb/cockroach/pkg/util/log/logpb"
+	"github.com/cockroachdb/cockroach/pkg/util/metric"
 	"github.com/cockroachdb/cockroach/pkg/util/mon"
 	"github.com/cockroachdb/cockroach/pkg/util/netutil"
 	"github.com/cockroachdb/cockroach/pkg/util/ring"
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
 	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
 	"github.com/cockroachdb/cockroach/pkg/util/tracing"
 	"github.com/cockroachdb/errors"
@@ -112,6 +114,75 @@ type conn struct {
 
 	// afterReadMsgTestingKnob is called after reading every message.
 	afterReadMsgTestingKnob func(context.Context) error
+
+	// msgLatency tracks the client messages whose latency is recorded once
+	// the connExecutor is done with their last command. It is accessed by both
+	// the reader and the processor goroutines.
+	msgLatency struct {
+		syncutil.Mutex
+		// pending are the messages whose last command hasn't been closed yet,
+		// in position order.
+		pending []pendingClientMsg
+		// lastPos is the position of the last command of the last message
+		// added to pending.
+		lastPos sql.CmdPos
+		// closedPos is the highest position of a closed command result.
+		closedPos sql.CmdPos
+	}
+}
+
+// pendingClientMsg is a client message whose last command, at position pos,
+// hasn't been executed yet.
+type pendingClientMsg struct {
+	pos          sql.CmdPos
+	timeReceived time.Time
+	latency      *metric.Histogram
+}
+
+// trackClientMsgLatency is called by the reader goroutine after a client
+// message has been handled, so that its latency is recorded when the result
+// of the last command it pushed is closed. Messages that didn't push any
+// command aren't tracked.
+func (c *conn) trackClientMsgLatency(typ pgwirebase.ClientMessageType, timeReceived time.Time) {
+	latency := c.metrics.clientMsgLatency(typ)
+	if latency == nil {
+		return
+	}
+	pos := c.stmtBuf.LastPos()
+	c.msgLatency.Lock()
+	defer c.msgLatency.Unlock()
+	if pos <= c.msgLatency.lastPos {
+		return
+	}
+	c.msgLatency.lastPos = pos
+	if pos <= c.msgLatency.closedPos {
+		// The connExecutor was faster than us.
+		latency.RecordValue(timeutil.Since(timeReceived).Nanoseconds())
+		return
+	}
+	c.msgLatency.pending = append(c.msgLatency.pending, pendingClientMsg{
+		pos: pos, timeReceived: timeReceived, latency: latency,
+	})
+}
+
+// recordClientMsgLatency is called by the processor goroutine when the result
+// of the command at position pos is closed. Messages whose last command comes
+// before pos are dropped: the connExecutor skipped it, for example because of
+// an error earlier in the batch.
+func (c *conn) recordClientMsgLatency(pos sql.CmdPos) {
+	c.msgLatency.Lock()
+	defer c.msgLatency.Unlock()
+	if pos > c.msgLatency.closedPos {
+		c.msgLatency.closedPos = pos
+	}
+	pending := c.msgLatency.pending
+	for len(pending) > 0 && pending[0].pos <= pos {
+		if m := pending[0]; m.pos == pos {
+			m.latency.RecordValue(timeutil.Since(m.timeReceived).Nanoseconds())
+		}
+		pending = pending[1:]
+	}
+	c.msgLatency.pending = pending
 }
 
 // serveConn creates a conn that will serve the netConn. It returns once the
@@ -198,6 +269,8 @@ func newConn(
 		readBuf:     pgwirebase.MakeReadBuffer(pgwirebase.ReadBufferOptionWithClusterSettings(sv)),
 	}
 	c.stmtBuf.Init()
+	c.msgLatency.lastPos = -1
+	c.msgLatency.closedPos = -1
 	c.res.released = true
 	c.writerState.fi.buf = &c.writerState.buf
 	c.writerState.fi.lastFlushed = -1
@@ -438,6 +511,8 @@ func (c *conn) serveImpl(
 			}
 			timeReceived := timeutil.Now()
 			log.VEventf(ctx, 2, "pgwire: processing %s", typ)
+			c.metrics.recordClientMsg(typ, n)
+			defer c.trackClientMsgLatency(typ, timeReceived)
 
 			if ignoreUntilSync {
 				if typ != pgwirebase.ClientMsgSync {
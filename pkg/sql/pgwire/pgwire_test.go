@@ -40,6 +40,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/testutils/sqlutils"
 	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
 	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/metric"
 	"github.com/cockroachdb/errors"
 	pgproto3 "github.com/jackc/pgproto3/v2"
 	pgx "github.com/jackc/pgx/v4"
@@ -1690,6 +1691,57 @@ func TestSQLNetworkMetrics(t *testing.T) {
 		conns[i].Close()
 		expectConns(i)
 	}
+
+	// A query with arguments goes through the extended protocol, so each
+	// of its messages should be counted.
+	db, err := gosql.Open("postgres", pgURL.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	simpleQueries := s.MustGetSQLNetworkCounter(pgwire.MetaPGWireMsgSimpleQuery.Name)
+	if _, err := db.Exec("SELECT $1::INT", 1); err != nil {
+		t.Fatal(err)
+	}
+	for _, meta := range []metric.Metadata{
+		pgwire.MetaPGWireMsgParse,
+		pgwire.MetaPGWireMsgBind,
+		pgwire.MetaPGWireMsgDescribe,
+		pgwire.MetaPGWireMsgExecute,
+		pgwire.MetaPGWireMsgSync,
+	} {
+		if c := s.MustGetSQLNetworkCounter(meta.Name); c == 0 {
+			t.Errorf("expected %s to be non-zero", meta.Name)
+		}
+	}
+	if c := s.MustGetSQLNetworkCounter(pgwire.MetaPGWireMsgSimpleQuery.Name); c != simpleQueries {
+		t.Errorf("expected %d simple queries, found %d", simpleQueries, c)
+	}
+	metrics := s.(*server.TestServer).PGServer().(*pgwire.Server).Metrics()[0].(*pgwire.ServerMetrics)
+	for _, h := range []*metric.Histogram{
+		metrics.PGWireMsgParseSize,
+		metrics.PGWireMsgBindSize,
+		metrics.PGWireMsgExecuteSize,
+	} {
+		if h.TotalCount() == 0 {
+			t.Errorf("expected %s to have samples", h.Name)
+		}
+	}
+	// The latency of a message may be recorded by the reader goroutine after
+	// the client got the response, if the connExecutor was faster than it.
+	testutils.SucceedsSoon(t, func() error {
+		for _, h := range []*metric.Histogram{
+			metrics.PGWireMsgParseLatency,
+			metrics.PGWireMsgBindLatency,
+			metrics.PGWireMsgExecuteLatency,
+			metrics.PGWireMsgSyncLatency,
+		} {
+			if h.TotalCount() == 0 {
+				return errors.Errorf("expected %s to have samples", h.Name)
+			}
+		}
+		return nil
+	})
 }
 
 func TestPGWireOverUnixSocket(t *testing.T) {
@@ -171,6 +171,150 @@ var (
 		Measurement: "Requests",
 		Unit:        metric.Unit_COUNT,
 	}
+	MetaPGWireMsgSimpleQuery = metric.Metadata{
+		Name:        "sql.pgwire_msg.simple_query",
+		Help:        "Counter of the number of pgwire Query messages received",
+		Measurement: "Messages",
+		Unit:        metric.Unit_COUNT,
+	}
+	MetaPGWireMsgParse = metric.Metadata{
+		Name:        "sql.pgwire_msg.parse",
+		Help:        "Counter of the number of pgwire Parse messages received",
+		Measurement: "Messages",
+		Unit:        metric.Unit_COUNT,
+	}
+	MetaPGWireMsgBind = metric.Metadata{
+		Name:        "sql.pgwire_msg.bind",
+		Help:        "Counter of the number of pgwire Bind messages received",
+		Measurement: "Messages",
+		Unit:        metric.Unit_COUNT,
+	}
+	MetaPGWireMsgDescribe = metric.Metadata{
+		Name:        "sql.pgwire_msg.describe",
+		Help:        "Counter of the number of pgwire Describe messages received",
+		Measurement: "Messages",
+		Unit:        metric.Unit_COUNT,
+	}
+	MetaPGWireMsgExecute = metric.Metadata{
+		Name:        "sql.pgwire_msg.execute",
+		Help:        "Counter of the number of pgwire Execute messages received",
+		Measurement: "Messages",
+		Unit:        metric.Unit_COUNT,
+	}
+	MetaPGWireMsgSync = metric.Metadata{
+		Name:        "sql.pgwire_msg.sync",
+		Help:        "Counter of the number of pgwire Sync messages received",
+		Measurement: "Messages",
+		Unit:        metric.Unit_COUNT,
+	}
+	MetaPGWireMsgClose = metric.Metadata{
+		Name:        "sql.pgwire_msg.close",
+		Help:        "Counter of the number of pgwire Close messages received",
+		Measurement: "Messages",
+		Unit:        metric.Unit_COUNT,
+	}
+	MetaPGWireMsgFlush = metric.Metadata{
+		Name:        "sql.pgwire_msg.flush",
+		Help:        "Counter of the number of pgwire Flush messages received",
+		Measurement: "Messages",
+		Unit:        metric.Unit_COUNT,
+	}
+	MetaPGWireMsgSimpleQuerySize = metric.Metadata{
+		Name:        "sql.pgwire_msg.simple_query.size",
+		Help:        "Size of the pgwire Query messages received",
+		Measurement: "Bytes",
+		Unit:        metric.Unit_BYTES,
+	}
+	MetaPGWireMsgParseSize = metric.Metadata{
+		Name:        "sql.pgwire_msg.parse.size",
+		Help:        "Size of the pgwire Parse messages received",
+		Measurement: "Bytes",
+		Unit:        metric.Unit_BYTES,
+	}
+	MetaPGWireMsgBindSize = metric.Metadata{
+		Name:        "sql.pgwire_msg.bind.size",
+		Help:        "Size of the pgwire Bind messages received",
+		Measurement: "Bytes",
+		Unit:        metric.Unit_BYTES,
+	}
+	MetaPGWireMsgDescribeSize = metric.Metadata{
+		Name:        "sql.pgwire_msg.describe.size",
+		Help:        "Size of the pgwire Describe messages received",
+		Measurement: "Bytes",
+		Unit:        metric.Unit_BYTES,
+	}
+	MetaPGWireMsgExecuteSize = metric.Metadata{
+		Name:        "sql.pgwire_msg.execute.size",
+		Help:        "Size of the pgwire Execute messages received",
+		Measurement: "Bytes",
+		Unit:        metric.Unit_BYTES,
+	}
+	MetaPGWireMsgSyncSize = metric.Metadata{
+		Name:        "sql.pgwire_msg.sync.size",
+		Help:        "Size of the pgwire Sync messages received",
+		Measurement: "Bytes",
+		Unit:        metric.Unit_BYTES,
+	}
+	MetaPGWireMsgCloseSize = metric.Metadata{
+		Name:        "sql.pgwire_msg.close.size",
+		Help:        "Size of the pgwire Close messages received",
+		Measurement: "Bytes",
+		Unit:        metric.Unit_BYTES,
+	}
+	MetaPGWireMsgFlushSize = metric.Metadata{
+		Name:        "sql.pgwire_msg.flush.size",
+		Help:        "Size of the pgwire Flush messages received",
+		Measurement: "Bytes",
+		Unit:        metric.Unit_BYTES,
+	}
+	MetaPGWireMsgSimpleQueryLatency = metric.Metadata{
+		Name:        "sql.pgwire_msg.simple_query.latency",
+		Help:        "Latency of the pgwire Query messages, from their receipt until their last command is executed",
+		Measurement: "Latency",
+		Unit:        metric.Unit_NANOSECONDS,
+	}
+	MetaPGWireMsgParseLatency = metric.Metadata{
+		Name:        "sql.pgwire_msg.parse.latency",
+		Help:        "Latency of the pgwire Parse messages, from their receipt until their last command is executed",
+		Measurement: "Latency",
+		Unit:        metric.Unit_NANOSECONDS,
+	}
+	MetaPGWireMsgBindLatency = metric.Metadata{
+		Name:        "sql.pgwire_msg.bind.latency",
+		Help:        "Latency of the pgwire Bind messages, from their receipt until their last command is executed",
+		Measurement: "Latency",
+		Unit:        metric.Unit_NANOSECONDS,
+	}
+	MetaPGWireMsgDescribeLatency = metric.Metadata{
+		Name:        "sql.pgwire_msg.describe.latency",
+		Help:        "Latency of the pgwire Describe messages, from their receipt until their last command is executed",
+		Measurement: "Latency",
+		Unit:        metric.Unit_NANOSECONDS,
+	}
+	MetaPGWireMsgExecuteLatency = metric.Metadata{
+		Name:        "sql.pgwire_msg.execute.latency",
+		Help:        "Latency of the pgwire Execute messages, from their receipt until their last command is executed",
+		Measurement: "Latency",
+		Unit:        metric.Unit_NANOSECONDS,
+	}
+	MetaPGWireMsgSyncLatency = metric.Metadata{
+		Name:        "sql.pgwire_msg.sync.latency",
+		Help:        "Latency of the pgwire Sync messages, from their receipt until their last command is executed",
+		Measurement: "Latency",
+		Unit:        metric.Unit_NANOSECONDS,
+	}
+	MetaPGWireMsgCloseLatency = metric.Metadata{
+		Name:        "sql.pgwire_msg.close.latency",
+		Help:        "Latency of the pgwire Close messages, from their receipt until their last command is executed",
+		Measurement: "Latency",
+		Unit:        metric.Unit_NANOSECONDS,
+	}
+	MetaPGWireMsgFlushLatency = metric.Metadata{
+		Name:        "sql.pgwire_msg.flush.latency",
+		Help:        "Latency of the pgwire Flush messages, from their receipt until their last command is executed",
+		Measurement: "Latency",
+		Unit:        metric.Unit_NANOSECONDS,
+	}
 )
 
 const (
@@ -284,6 +428,30 @@ type ServerMetrics struct {
 	PGWireCancelTotalCount      *metric.Counter
 	PGWireCancelIgnoredCount    *metric.Counter
 	PGWireCancelSuccessfulCount *metric.Counter
+	PGWireMsgSimpleQueryCount   *metric.Counter
+	PGWireMsgParseCount         *metric.Counter
+	PGWireMsgBindCount          *metric.Counter
+	PGWireMsgDescribeCount      *metric.Counter
+	PGWireMsgExecuteCount       *metric.Counter
+	PGWireMsgSyncCount          *metric.Counter
+	PGWireMsgCloseCount         *metric.Counter
+	PGWireMsgFlushCount         *metric.Counter
+	PGWireMsgSimpleQuerySize    *metric.Histogram
+	PGWireMsgParseSize          *metric.Histogram
+	PGWireMsgBindSize           *metric.Histogram
+	PGWireMsgDescribeSize       *metric.Histogram
+	PGWireMsgExecuteSize        *metric.Histogram
+	PGWireMsgSyncSize           *metric.Histogram
+	PGWireMsgCloseSize          *metric.Histogram
+	PGWireMsgFlushSize          *metric.Histogram
+	PGWireMsgSimpleQueryLatency *metric.Histogram
+	PGWireMsgParseLatency       *metric.Histogram
+	PGWireMsgBindLatency        *metric.Histogram
+	PGWireMsgDescribeLatency    *metric.Histogram
+	PGWireMsgExecuteLatency     *metric.Histogram
+	PGWireMsgSyncLatency        *metric.Histogram
+	PGWireMsgCloseLatency       *metric.Histogram
+	PGWireMsgFlushLatency       *metric.Histogram
 	ConnMemMetrics              sql.BaseMemoryMetrics
 	SQLMemMetrics               sql.MemoryMetrics
 }
@@ -301,11 +469,99 @@ func makeServerMetrics(
 		PGWireCancelTotalCount:      metric.NewCounter(MetaPGWireCancelTotal),
 		PGWireCancelIgnoredCount:    metric.NewCounter(MetaPGWireCancelIgnored),
 		PGWireCancelSuccessfulCount: metric.NewCounter(MetaPGWireCancelSuccessful),
+		PGWireMsgSimpleQueryCount:   metric.NewCounter(MetaPGWireMsgSimpleQuery),
+		PGWireMsgParseCount:         metric.NewCounter(MetaPGWireMsgParse),
+		PGWireMsgBindCount:          metric.NewCounter(MetaPGWireMsgBind),
+		PGWireMsgDescribeCount:      metric.NewCounter(MetaPGWireMsgDescribe),
+		PGWireMsgExecuteCount:       metric.NewCounter(MetaPGWireMsgExecute),
+		PGWireMsgSyncCount:          metric.NewCounter(MetaPGWireMsgSync),
+		PGWireMsgCloseCount:         metric.NewCounter(MetaPGWireMsgClose),
+		PGWireMsgFlushCount:         metric.NewCounter(MetaPGWireMsgFlush),
+		PGWireMsgSimpleQuerySize:    metric.NewHistogram(MetaPGWireMsgSimpleQuerySize, histogramWindow, maxPGWireMsgSize, 1),
+		PGWireMsgParseSize:          metric.NewHistogram(MetaPGWireMsgParseSize, histogramWindow, maxPGWireMsgSize, 1),
+		PGWireMsgBindSize:           metric.NewHistogram(MetaPGWireMsgBindSize, histogramWindow, maxPGWireMsgSize, 1),
+		PGWireMsgDescribeSize:       metric.NewHistogram(MetaPGWireMsgDescribeSize, histogramWindow, maxPGWireMsgSize, 1),
+		PGWireMsgExecuteSize:        metric.NewHistogram(MetaPGWireMsgExecuteSize, histogramWindow, maxPGWireMsgSize, 1),
+		PGWireMsgSyncSize:           metric.NewHistogram(MetaPGWireMsgSyncSize, histogramWindow, maxPGWireMsgSize, 1),
+		PGWireMsgCloseSize:          metric.NewHistogram(MetaPGWireMsgCloseSize, histogramWindow, maxPGWireMsgSize, 1),
+		PGWireMsgFlushSize:          metric.NewHistogram(MetaPGWireMsgFlushSize, histogramWindow, maxPGWireMsgSize, 1),
+		PGWireMsgSimpleQueryLatency: metric.NewLatency(MetaPGWireMsgSimpleQueryLatency, histogramWindow),
+		PGWireMsgParseLatency:       metric.NewLatency(MetaPGWireMsgParseLatency, histogramWindow),
+		PGWireMsgBindLatency:        metric.NewLatency(MetaPGWireMsgBindLatency, histogramWindow),
+		PGWireMsgDescribeLatency:    metric.NewLatency(MetaPGWireMsgDescribeLatency, histogramWindow),
+		PGWireMsgExecuteLatency:     metric.NewLatency(MetaPGWireMsgExecuteLatency, histogramWindow),
+		PGWireMsgSyncLatency:        metric.NewLatency(MetaPGWireMsgSyncLatency, histogramWindow),
+		PGWireMsgCloseLatency:       metric.NewLatency(MetaPGWireMsgCloseLatency, histogramWindow),
+		PGWireMsgFlushLatency:       metric.NewLatency(MetaPGWireMsgFlushLatency, histogramWindow),
 		ConnMemMetrics:              sql.MakeBaseMemMetrics("conns", histogramWindow),
 		SQLMemMetrics:               sqlMemMetrics,
 	}
 }
 
+// maxPGWireMsgSize is the maximum value of the message size histograms. It is
+// the default of sql.conn.max_read_buffer_message_size; larger messages, which
+// can only be read if the setting is raised, are recorded as this value.
+const maxPGWireMsgSize = 1 << 24
+
+// recordClientMsg increments the counter and records the size n in the
+// histogram of the given client message type. All the message types of the
+// simple and extended protocols are tracked; the startup, authentication, COPY
+// and Terminate messages aren't.
+func (m *ServerMetrics) recordClientMsg(typ pgwirebase.ClientMessageType, n int) {
+	switch typ {
+	case pgwirebase.ClientMsgSimpleQuery:
+		m.PGWireMsgSimpleQueryCount.Inc(1)
+		m.PGWireMsgSimpleQuerySize.RecordValue(int64(n))
+	case pgwirebase.ClientMsgParse:
+		m.PGWireMsgParseCount.Inc(1)
+		m.PGWireMsgParseSize.RecordValue(int64(n))
+	case pgwirebase.ClientMsgBind:
+		m.PGWireMsgBindCount.Inc(1)
+		m.PGWireMsgBindSize.RecordValue(int64(n))
+	case pgwirebase.ClientMsgDescribe:
+		m.PGWireMsgDescribeCount.Inc(1)
+		m.PGWireMsgDescribeSize.RecordValue(int64(n))
+	case pgwirebase.ClientMsgExecute:
+		m.PGWireMsgExecuteCount.Inc(1)
+		m.PGWireMsgExecuteSize.RecordValue(int64(n))
+	case pgwirebase.ClientMsgSync:
+		m.PGWireMsgSyncCount.Inc(1)
+		m.PGWireMsgSyncSize.RecordValue(int64(n))
+	case pgwirebase.ClientMsgClose:
+		m.PGWireMsgCloseCount.Inc(1)
+		m.PGWireMsgCloseSize.RecordValue(int64(n))
+	case pgwirebase.ClientMsgFlush:
+		m.PGWireMsgFlushCount.Inc(1)
+		m.PGWireMsgFlushSize.RecordValue(int64(n))
+	}
+}
+
+// clientMsgLatency returns the latency histogram of the given client message
+// type, or nil if the latency of the message type isn't tracked. The same
+// message types as in recordClientMsg are tracked.
+func (m *ServerMetrics) clientMsgLatency(typ pgwirebase.ClientMessageType) *metric.Histogram {
+	switch typ {
+	case pgwirebase.ClientMsgSimpleQuery:
+		return m.PGWireMsgSimpleQueryLatency
+	case pgwirebase.ClientMsgParse:
+		return m.PGWireMsgParseLatency
+	case pgwirebase.ClientMsgBind:
+		return m.PGWireMsgBindLatency
+	case pgwirebase.ClientMsgDescribe:
+		return m.PGWireMsgDescribeLatency
+	case pgwirebase.ClientMsgExecute:
+		return m.PGWireMsgExecuteLatency
+	case pgwirebase.ClientMsgSync:
+		return m.PGWireMsgSyncLatency
+	case pgwirebase.ClientMsgClose:
+		return m.PGWireMsgCloseLatency
+	case pgwirebase.ClientMsgFlush:
+		return m.PGWireMsgFlushLatency
+	default:
+		return nil
+	}
+}
+
 // noteworthySQLMemoryUsageBytes is the minimum size tracked by the
 // client SQL pool before the pool start explicitly logging overall
 // usage growth in the log.
@@ -671,8 +671,11 @@ func (opc *optPlanningCtx) runExecBuilder(
 
 	if stmt.ExpectedTypes != nil {
 		cols := result.main.planColumns()
-		if !stmt.ExpectedTypes.TypesEqual(cols) {
-			return pgerror.New(pgcode.FeatureNotSupported, "cached plan must not change result type")
+		if ok, reason := stmt.ExpectedTypes.TypesEqualWithReason(cols); !ok {
+			return errors.WithDetail(
+				pgerror.New(pgcode.FeatureNotSupported, "cached plan must not change result type"),
+				reason,
+			)
 		}
 	}
 
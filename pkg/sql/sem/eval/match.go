@@ -82,6 +82,17 @@ func ConvertLikeToRegexp(
 	return re, nil
 }
 
+// ConvertToRegexp compiles the specified regular expression pattern, as
+// used by the ~ family of comparison operators.
+func ConvertToRegexp(ctx *Context, pattern string, caseInsensitive bool) (*regexp.Regexp, error) {
+	key := regexpKey{s: pattern, caseInsensitive: caseInsensitive}
+	re, err := ctx.ReCache.GetRegexp(key)
+	if err != nil {
+		return nil, pgerror.Wrap(err, pgcode.InvalidRegularExpression, "invalid regular expression")
+	}
+	return re, nil
+}
+
 func matchLike(ctx *Context, left, right tree.Datum, caseInsensitive bool) (tree.Datum, error) {
 	if left == tree.DNull || right == tree.DNull {
 		return tree.DNull, nil
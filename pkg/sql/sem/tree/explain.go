@@ -253,8 +253,8 @@ func MakeExplain(options []string, stmt Statement) (Statement, error) {
 		opts.Mode = ExplainPlan
 	}
 	if opts.Flags[ExplainFlagJSON] {
-		if opts.Mode != ExplainDistSQL {
-			return nil, pgerror.Newf(pgcode.Syntax, "the JSON flag can only be used with DISTSQL")
+		if opts.Mode != ExplainPlan && opts.Mode != ExplainDistSQL {
+			return nil, pgerror.Newf(pgcode.Syntax, "the JSON flag can only be used with PLAN or DISTSQL")
 		}
 		if analyze {
 			return nil, pgerror.Newf(pgcode.Syntax, "the JSON flag cannot be used with ANALYZE")
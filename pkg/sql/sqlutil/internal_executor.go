@@ -226,3 +226,12 @@ type InternalExecFn func(ctx context.Context, txn *kv.Txn, ie InternalExecutor)
 // passes the fn the exported InternalExecutor instead of the whole unexported
 // extendedEvalContenxt, so it can be implemented outside pkg/sql.
 type HistoricalInternalExecTxnRunner func(ctx context.Context, fn InternalExecFn) error
+
+// DatumProvider can be implemented by types that are passed as query arguments
+// to the InternalExecutor in order to control how they are converted into
+// datums. This allows for domain-specific types to be used as arguments without
+// having to teach the InternalExecutor about them.
+type DatumProvider interface {
+	// ToDatum returns the datum that the value is converted to.
+	ToDatum() (tree.Datum, error)
+}
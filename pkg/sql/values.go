@@ -52,53 +52,62 @@ func (p *planner) newContainerValuesNode(columns colinfo.ResultColumns, capacity
 
 // valuesRun is the run-time state of a valuesNode during local execution.
 type valuesRun struct {
+	// rows is only set when the valuesNode stores rows that were added to it
+	// externally. If it is nil, the rows are evaluated from the tuples one at a
+	// time.
 	rows    *rowcontainer.RowContainer
 	nextRow int // The index of the next row.
+	// evalRow is the last row that has been evaluated from the tuples.
+	evalRow tree.Datums
+	evalCtx *eval.Context
 }
 
 func (n *valuesNode) startExec(params runParams) error {
-	if n.rows != nil {
-		// n.rows was already created in newContainerValuesNode.
-		// Nothing to do here.
-		return nil
-	}
-
-	// This node is coming from a SQL query (as opposed to sortNode and
-	// others that create a valuesNode internally for storing results
-	// from other planNodes), so its expressions need evaluating.
-	// This may run subqueries.
-	n.rows = rowcontainer.NewRowContainerWithCapacity(
-		params.extendedEvalCtx.Mon.MakeBoundAccount(),
-		colinfo.ColTypeInfoFromResCols(n.columns),
-		len(n.tuples),
-	)
-
-	row := make([]tree.Datum, len(n.columns))
-	for _, tupleRow := range n.tuples {
-		for i, typedExpr := range tupleRow {
-			var err error
-			row[i], err = eval.Expr(params.EvalContext(), typedExpr)
-			if err != nil {
-				return err
-			}
-		}
-		if _, err := n.rows.AddRow(params.ctx, row); err != nil {
-			return err
-		}
-	}
-
+	// If n.rows wasn't already created in newContainerValuesNode, this node is
+	// coming from a SQL query (as opposed to sortNode and others that create a
+	// valuesNode internally for storing results from other planNodes), so its
+	// expressions need evaluating. This is done lazily in Next so that large
+	// VALUES clauses don't have to be materialized in memory in their entirety.
+	n.evalCtx = params.EvalContext()
 	return nil
 }
 
-func (n *valuesNode) Next(runParams) (bool, error) {
-	if n.nextRow >= n.rows.Len() {
+func (n *valuesNode) Next(params runParams) (bool, error) {
+	if n.rows != nil {
+		if n.nextRow >= n.rows.Len() {
+			return false, nil
+		}
+		n.nextRow++
+		return true, nil
+	}
+	if n.nextRow >= len(n.tuples) {
 		return false, nil
 	}
+	// Evaluating large VALUES clauses can take a while, so make sure that the
+	// query can be canceled in the middle of it.
+	if err := params.p.cancelChecker.Check(); err != nil {
+		return false, err
+	}
+	// Allocate a new row every time since the consumers are allowed to hold on
+	// to the rows returned by Values (as they could with the row container).
+	// This may run subqueries.
+	row := make(tree.Datums, len(n.columns))
+	for i, typedExpr := range n.tuples[n.nextRow] {
+		var err error
+		row[i], err = eval.Expr(n.evalCtx, typedExpr)
+		if err != nil {
+			return false, err
+		}
+	}
+	n.evalRow = row
 	n.nextRow++
 	return true, nil
 }
 
 func (n *valuesNode) Values() tree.Datums {
+	if n.rows == nil {
+		return n.evalRow
+	}
 	return n.rows.At(n.nextRow - 1)
 }
 
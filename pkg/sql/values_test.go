@@ -11,17 +11,32 @@
 package sql
 
 import (
+	"context"
+	gosql "database/sql"
+	"database/sql/driver"
+	gojson "encoding/json"
+	"math/big"
+	"net"
 	"testing"
 	"time"
 
 	"github.com/cockroachdb/apd/v3"
+	"github.com/cockroachdb/cockroach/pkg/base"
 	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/colinfo"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/testutils/serverutils"
 	"github.com/cockroachdb/cockroach/pkg/util/bitarray"
+	"github.com/cockroachdb/cockroach/pkg/util/cancelchecker"
 	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
 	"github.com/cockroachdb/cockroach/pkg/util/log"
 	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+	"github.com/cockroachdb/cockroach/pkg/util/uuid"
+	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -29,6 +44,28 @@ type floatAlias float32
 type boolAlias bool
 type stringAlias string
 
+// testDecimal mimics the API of third-party decimal libraries such as
+// github.com/shopspring/decimal.
+type testDecimal struct {
+	coeff int64
+	exp   int32
+}
+
+func (d testDecimal) Coefficient() *big.Int { return big.NewInt(d.coeff) }
+func (d testDecimal) Exponent() int32       { return d.exp }
+
+type datumProvider struct{ s string }
+
+func (p datumProvider) ToDatum() (tree.Datum, error) {
+	return tree.NewDName(p.s), nil
+}
+
+// unsupportedValuer converts to a type that golangFillQueryArguments doesn't
+// support.
+type unsupportedValuer struct{}
+
+func (unsupportedValuer) Value() (driver.Value, error) { return struct{}{}, nil }
+
 func TestGolangQueryArgs(t *testing.T) {
 	defer leaktest.AfterTest(t)()
 	defer log.Scope(t).Close(t)
@@ -64,6 +101,11 @@ func TestGolangQueryArgs(t *testing.T) {
 
 		// Decimal type.
 		{apd.New(55, 1), types.Decimal},
+		{big.NewRat(1, 4), types.Decimal},
+		{(*big.Rat)(nil), types.Unknown},
+		{testDecimal{coeff: -1234, exp: -2}, types.Decimal},
+		{(*testDecimal)(nil), types.Unknown},
+		{&testDecimal{coeff: 5, exp: 3}, types.Decimal},
 
 		// String type.
 		{"test", types.String},
@@ -86,6 +128,46 @@ func TestGolangQueryArgs(t *testing.T) {
 		{roachpb.Key("key"), types.Bytes},
 		{roachpb.RKey("key"), types.Bytes},
 
+		// Array types.
+		{[]string{"a", "b"}, types.StringArray},
+		{[]int{1, 2}, types.IntArray},
+		{[]int64{1, 2}, types.IntArray},
+		{[]uint32{1, 2}, types.IntArray},
+		{[]float64{1.5}, types.FloatArray},
+		{[]bool{true}, types.BoolArray},
+		{[]stringAlias{"a"}, types.StringArray},
+
+		// UUID type.
+		{uuid.MakeV4(), types.Uuid},
+		{[16]byte{}, types.Uuid},
+
+		// INet type.
+		{net.ParseIP("192.168.0.1"), types.INet},
+		{net.ParseIP("::1"), types.INet},
+		{&net.IPNet{IP: net.ParseIP("10.0.0.0"), Mask: net.CIDRMask(8, 32)}, types.INet},
+		{net.IP(nil), types.Unknown},
+
+		// JSON type.
+		{gojson.RawMessage(`{"a": [1, 2]}`), types.Jsonb},
+		{map[string]interface{}{"a": "b", "c": []interface{}{1, true}}, types.Jsonb},
+		{gojson.RawMessage(nil), types.Unknown},
+
+		// Nullable wrappers.
+		{gosql.NullString{String: "a", Valid: true}, types.String},
+		{gosql.NullString{}, types.Unknown},
+		{gosql.NullInt64{Int64: 1, Valid: true}, types.Int},
+		{gosql.NullBool{Bool: true, Valid: true}, types.Bool},
+		{gosql.NullFloat64{}, types.Unknown},
+		{gosql.NullTime{Time: timeutil.Now(), Valid: true}, types.Timestamp},
+		{uuid.NullUUID{UUID: uuid.MakeV4(), Valid: true}, types.Uuid},
+		{uuid.NullUUID{}, types.Unknown},
+		{&gosql.NullString{String: "a", Valid: true}, types.String},
+		{(*gosql.NullString)(nil), types.Unknown},
+
+		// Custom conversion.
+		{datumProvider{s: "name"}, types.Name},
+		{(*datumProvider)(nil), types.Unknown},
+
 		// Bit array.
 		{bitarray.MakeBitArrayFromInt64(8, 58, 7), types.VarBit},
 	}
@@ -102,3 +184,181 @@ func TestGolangQueryArgs(t *testing.T) {
 		}
 	}
 }
+
+func TestGolangQueryArgsDecimal(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	for _, tc := range []struct {
+		value    interface{}
+		expected string
+	}{
+		{big.NewRat(1, 4), "0.25"},
+		{big.NewRat(-10, 1), "-10"},
+		{big.NewRat(1, 3), "0.33333333333333333333"},
+		{testDecimal{coeff: -1234, exp: -2}, "-12.34"},
+		{testDecimal{coeff: 5, exp: 3}, "5E+3"},
+	} {
+		datums, err := golangFillQueryArguments(tc.value)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, datums[0].String())
+	}
+}
+
+func TestGolangQueryArgsTime(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	abidjan, err := timeutil.LoadLocation("Africa/Abidjan")
+	require.NoError(t, err)
+	london, err := timeutil.LoadLocation("Europe/London")
+	require.NoError(t, err)
+	// Abidjan is always at UTC+0, and London is at UTC+0 in winter, so these
+	// times have a zero offset but aren't in UTC.
+	inAbidjan := time.Date(2022, 1, 1, 0, 0, 0, 0, abidjan)
+	inLondon := time.Date(2022, 1, 1, 0, 0, 0, 0, london)
+	inUTCPlus1 := time.Date(2022, 1, 1, 0, 0, 0, 0, time.FixedZone("UTC+1", 3600))
+
+	// Callers that need a TIMESTAMP regardless of the zone can pass t.UTC() or
+	// a *tree.DTimestamp.
+	asTimestamp, err := tree.MakeDTimestamp(inLondon, time.Microsecond)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc     string
+		value    interface{}
+		expected *types.T
+	}{
+		{desc: "now", value: timeutil.Now(), expected: types.Timestamp},
+		{desc: "UTC+1", value: inUTCPlus1, expected: types.TimestampTZ},
+		{desc: "UTC+1 in UTC", value: inUTCPlus1.UTC(), expected: types.Timestamp},
+		{desc: "Abidjan", value: inAbidjan, expected: types.TimestampTZ},
+		{desc: "London", value: inLondon, expected: types.TimestampTZ},
+		{desc: "London in UTC", value: inLondon.UTC(), expected: types.Timestamp},
+		{desc: "DTimestamp", value: asTimestamp, expected: types.Timestamp},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			datums, err := golangFillQueryArguments(tc.value)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, datums[0].ResolvedType())
+		})
+	}
+}
+
+func TestGolangQueryArgsUnsupportedType(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	_, err := golangFillQueryArguments(1, struct{}{})
+	require.Error(t, err)
+	require.Equal(t, pgcode.InvalidParameterValue, pgerror.GetPGCode(err))
+	require.Contains(t, err.Error(), "unsupported type struct {} for argument 2")
+
+	_, err = golangFillQueryArguments([]chan int{nil})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unsupported type []chan int for argument 1")
+
+	// Valuers are reported as unsupported at their own position.
+	_, err = golangFillQueryArguments(1, 2, unsupportedValuer{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unsupported type sql.unsupportedValuer for argument 3")
+}
+
+// TestValuesPlanJSON verifies the plan of a VALUES clause through EXPLAIN
+// (JSON) rather than by inspecting the valuesNode.
+func TestValuesPlanJSON(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	s, db, _ := serverutils.StartServer(t, base.TestServerArgs{})
+	defer s.Stopper().Stop(context.Background())
+
+	type attr struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}
+	type node struct {
+		Name     string `json:"name"`
+		Attrs    []attr `json:"attrs"`
+		Children []node `json:"children"`
+	}
+	for _, tc := range []struct {
+		query    string
+		expected node
+	}{
+		{
+			query: `VALUES (1)`,
+			expected: node{
+				Name:  "values",
+				Attrs: []attr{{Key: "size", Value: "1 column, 1 row"}},
+			},
+		},
+		{
+			query: `VALUES (1, 'a', true), (2, 'b', false)`,
+			expected: node{
+				Name:  "values",
+				Attrs: []attr{{Key: "size", Value: "3 columns, 2 rows"}},
+			},
+		},
+	} {
+		t.Run(tc.query, func(t *testing.T) {
+			var planJSON string
+			var plan struct {
+				Plan node `json:"plan"`
+			}
+			require.NoError(t, db.QueryRow("EXPLAIN (JSON) "+tc.query).Scan(&planJSON))
+			require.NoError(t, gojson.Unmarshal([]byte(planJSON), &plan))
+			require.Equal(t, tc.expected, plan.Plan)
+		})
+	}
+}
+
+// TestValuesNodeCancellation verifies that the evaluation of a large VALUES
+// clause stops shortly after the query is canceled. Unlike
+// TestValuesPlanJSON, it drives the valuesNode directly, since the plan
+// doesn't show when the node checks for cancellation.
+func TestValuesNodeCancellation(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The cancel checker only looks at the context once every 1024 calls, so
+	// cancel the query well into the VALUES clause, after some checks have
+	// already passed.
+	const numRows = 10000
+	const cancelAfter = 2000
+	tuples := make([][]tree.TypedExpr, numRows)
+	for i := range tuples {
+		tuples[i] = []tree.TypedExpr{tree.NewDInt(tree.DInt(i))}
+	}
+	n := &valuesNode{
+		columns:          colinfo.ResultColumns{{Name: "a", Typ: types.Int}},
+		tuples:           tuples,
+		specifiedInQuery: true,
+	}
+	p := &planner{}
+	p.cancelChecker.Reset(ctx)
+	params := runParams{ctx: ctx, extendedEvalCtx: &extendedEvalContext{}, p: p}
+	require.NoError(t, n.startExec(params))
+	defer n.Close(ctx)
+
+	var numRowsReturned int
+	for {
+		if numRowsReturned == cancelAfter {
+			cancel()
+		}
+		ok, err := n.Next(params)
+		if err != nil {
+			require.True(t, errors.Is(err, cancelchecker.QueryCanceledError), "unexpected error %v", err)
+			break
+		}
+		require.True(t, ok, "all rows were returned despite the cancellation")
+		numRowsReturned++
+	}
+	// The cancellation must have been noticed in the middle of the iteration,
+	// at the first check after it.
+	require.GreaterOrEqual(t, numRowsReturned, cancelAfter)
+	require.Less(t, numRowsReturned, cancelAfter+1024)
+}
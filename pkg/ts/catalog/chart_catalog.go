@@ -2612,6 +2612,48 @@ var charts = []sectionDescription{
 				},
 				AxisLabel: "Count",
 			},
+			{
+				Title: "Messages Received (Postgres Protocol)",
+				Metrics: []string{
+					"sql.pgwire_msg.simple_query",
+					"sql.pgwire_msg.parse",
+					"sql.pgwire_msg.bind",
+					"sql.pgwire_msg.describe",
+					"sql.pgwire_msg.execute",
+					"sql.pgwire_msg.sync",
+					"sql.pgwire_msg.close",
+					"sql.pgwire_msg.flush",
+				},
+				AxisLabel: "Messages",
+			},
+			{
+				Title: "Message Sizes (Postgres Protocol)",
+				Metrics: []string{
+					"sql.pgwire_msg.simple_query.size",
+					"sql.pgwire_msg.parse.size",
+					"sql.pgwire_msg.bind.size",
+					"sql.pgwire_msg.describe.size",
+					"sql.pgwire_msg.execute.size",
+					"sql.pgwire_msg.sync.size",
+					"sql.pgwire_msg.close.size",
+					"sql.pgwire_msg.flush.size",
+				},
+				AxisLabel: "Size",
+			},
+			{
+				Title: "Message Latency (Postgres Protocol)",
+				Metrics: []string{
+					"sql.pgwire_msg.simple_query.latency",
+					"sql.pgwire_msg.parse.latency",
+					"sql.pgwire_msg.bind.latency",
+					"sql.pgwire_msg.describe.latency",
+					"sql.pgwire_msg.execute.latency",
+					"sql.pgwire_msg.sync.latency",
+					"sql.pgwire_msg.close.latency",
+					"sql.pgwire_msg.flush.latency",
+				},
+				AxisLabel: "Latency",
+			},
 			{
 				Title: "SQL Transaction Contention",
 				Metrics: []string{
@@ -0,0 +1,114 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package auditreport
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Section identifies one of the parts of a Report.
+type Section string
+
+const (
+	// SectionDDL is the DDL timeline.
+	SectionDDL Section = "ddl"
+	// SectionUsers is the per-user activity summary.
+	SectionUsers Section = "users"
+	// SectionAuth is the summary of authentication failures.
+	SectionAuth Section = "auth"
+)
+
+// Sections lists all the report sections.
+var Sections = []Section{SectionDDL, SectionUsers, SectionAuth}
+
+// WriteJSON writes the report as a single JSON object.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteCSV writes the given section of the report as CSV, with a
+// header row.
+func (r *Report) WriteCSV(w io.Writer, section Section) error {
+	cw := csv.NewWriter(w)
+	switch section {
+	case SectionDDL:
+		_ = cw.Write([]string{"timestamp", "event_type", "user", "descriptor_id", "statement"})
+		for _, e := range r.DDLTimeline {
+			_ = cw.Write([]string{
+				formatTime(e.Timestamp),
+				e.EventType,
+				e.User,
+				strconv.FormatUint(uint64(e.DescriptorID), 10),
+				e.Statement,
+			})
+		}
+	case SectionUsers:
+		_ = cw.Write([]string{"user", "num_events", "first_seen", "last_seen", "event_counts"})
+		for _, u := range r.UserActivity {
+			_ = cw.Write([]string{
+				u.User,
+				strconv.Itoa(u.NumEvents),
+				formatTime(u.FirstSeen),
+				formatTime(u.LastSeen),
+				formatCounts(u.EventCounts),
+			})
+		}
+	case SectionAuth:
+		_ = cw.Write([]string{"ip", "num_failures", "first_seen", "last_seen", "users", "reasons"})
+		for _, a := range r.AuthFailures {
+			_ = cw.Write([]string{
+				a.IP,
+				strconv.Itoa(a.NumFailures),
+				formatTime(a.FirstSeen),
+				formatTime(a.LastSeen),
+				strings.Join(a.Users, ";"),
+				formatCounts(a.Reasons),
+			})
+		}
+	default:
+		return errors.Newf("unknown report section: %q", section)
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatTime(t time.Time) string {
+	return t.Format(time.RFC3339Nano)
+}
+
+// formatCounts formats a map of counts as "key=count" pairs separated
+// by semicolons, in key order.
+func formatCounts(m map[string]int) string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var buf strings.Builder
+	for i, k := range keys {
+		if i > 0 {
+			buf.WriteByte(';')
+		}
+		fmt.Fprintf(&buf, "%s=%d", k, m[k])
+	}
+	return buf.String()
+}
@@ -0,0 +1,81 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package auditreport
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/log/logpb"
+	"github.com/cockroachdb/errors"
+)
+
+var logFileRE = regexp.MustCompile(log.FilePattern)
+
+// ReadDir adds the structured events from all the log files in the
+// given directory. Files whose name does not match the log file name
+// pattern are ignored. Files are read in name order.
+func (b *Builder) ReadDir(dir string, editMode log.EditSensitiveData) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() || !logFileRE.MatchString(e.Name()) {
+			continue
+		}
+		names = append(names, e.Name())
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := b.ReadFile(filepath.Join(dir, name), editMode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadFile adds the structured events from the given log file.
+func (b *Builder) ReadFile(path string, editMode log.EditSensitiveData) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return errors.Wrapf(b.Read(bufio.NewReader(f), editMode), "reading %s", path)
+}
+
+// Read adds the structured events from the log entries in the given
+// stream. The log format is detected from the log file header.
+func (b *Builder) Read(in io.Reader, editMode log.EditSensitiveData) error {
+	d, err := log.NewEntryDecoder(in, editMode)
+	if err != nil {
+		return err
+	}
+	for {
+		var entry logpb.Entry
+		if err := d.Decode(&entry); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if err := b.AddEntry(&entry); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,264 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+// Package auditreport aggregates the structured events found in log
+// files into audit reports: a timeline of DDL operations, a summary of
+// the activity of each user, and authentication failures grouped by
+// client address.
+package auditreport
+
+import (
+	"encoding/json"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/util/log/eventpb"
+	"github.com/cockroachdb/cockroach/pkg/util/log/logpb"
+	"github.com/cockroachdb/errors"
+)
+
+// Report is an aggregated audit report.
+type Report struct {
+	// DDLTimeline lists the DDL events, in timestamp order.
+	DDLTimeline []DDLEvent `json:"ddl_timeline"`
+	// UserActivity summarizes the events attributed to each user,
+	// sorted by user name.
+	UserActivity []UserActivity `json:"user_activity"`
+	// AuthFailures summarizes the authentication failures by client
+	// address, sorted by address.
+	AuthFailures []AuthFailures `json:"auth_failures"`
+}
+
+// DDLEvent is an entry in the DDL timeline.
+type DDLEvent struct {
+	Timestamp    time.Time `json:"timestamp"`
+	EventType    string    `json:"event_type"`
+	User         string    `json:"user,omitempty"`
+	DescriptorID uint32    `json:"descriptor_id,omitempty"`
+	Statement    string    `json:"statement,omitempty"`
+}
+
+// UserActivity summarizes the events attributed to one user.
+type UserActivity struct {
+	User      string    `json:"user"`
+	NumEvents int       `json:"num_events"`
+	FirstSeen time.Time `json:"first_seen"`
+	LastSeen  time.Time `json:"last_seen"`
+	// EventCounts counts the events by event type.
+	EventCounts map[string]int `json:"event_counts"`
+}
+
+// AuthFailures summarizes the authentication failures originating
+// from one client address.
+type AuthFailures struct {
+	IP          string    `json:"ip"`
+	NumFailures int       `json:"num_failures"`
+	FirstSeen   time.Time `json:"first_seen"`
+	LastSeen    time.Time `json:"last_seen"`
+	// Users lists the distinct user names attempted, in sorted order.
+	Users []string `json:"users"`
+	// Reasons counts the failures by failure reason.
+	Reasons map[string]int `json:"reasons"`
+}
+
+// eventFields is the subset of the JSON payload of structured events
+// used to build reports. The field names are those of the event
+// payloads defined in package eventpb.
+type eventFields struct {
+	Timestamp     int64
+	EventType     string
+	User          string
+	Statement     string
+	DescriptorID  uint32
+	RemoteAddress string
+	Reason        eventpb.AuthFailReason
+}
+
+// Builder accumulates events into a Report.
+//
+// The zero value is not ready for use; use NewBuilder instead.
+type Builder struct {
+	ddl   []DDLEvent
+	users map[string]*UserActivity
+	auth  map[string]*AuthFailures
+
+	// events records the structured events added so far, and descriptors
+	// indexes them by the IDs of the descriptors they affect. They are
+	// used by ForDescriptor.
+	events      []storedEvent
+	descriptors eventpb.DescriptorIndex
+}
+
+// storedEvent is a structured event added to a Builder.
+type storedEvent struct {
+	ch      logpb.Channel
+	payload []byte
+}
+
+// NewBuilder creates a new Builder.
+func NewBuilder() *Builder {
+	return &Builder{
+		users:       make(map[string]*UserActivity),
+		auth:        make(map[string]*AuthFailures),
+		descriptors: eventpb.MakeDescriptorIndex(),
+	}
+}
+
+// ForDescriptor returns a Builder containing only the events added so
+// far that affect the descriptor with the given ID, including job
+// events that list it among their descriptors.
+func (b *Builder) ForDescriptor(id uint32) (*Builder, error) {
+	res := NewBuilder()
+	for _, pos := range b.descriptors.Lookup(id) {
+		ev := b.events[pos]
+		if err := res.AddEvent(ev.ch, ev.payload); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// AddEntry adds the structured event contained in the given log entry,
+// if any. Entries that do not contain a structured event are ignored.
+func (b *Builder) AddEntry(entry *logpb.Entry) error {
+	if entry.StructuredEnd == 0 {
+		return nil
+	}
+	if entry.StructuredStart > entry.StructuredEnd || int(entry.StructuredEnd) > len(entry.Message) {
+		return errors.Newf("invalid structured payload bounds [%d,%d) in entry of length %d",
+			entry.StructuredStart, entry.StructuredEnd, len(entry.Message))
+	}
+	return b.AddEvent(entry.Channel, []byte(entry.Message[entry.StructuredStart:entry.StructuredEnd]))
+}
+
+// AddEvent adds a structured event, given the channel it was logged to
+// and its JSON payload.
+func (b *Builder) AddEvent(ch logpb.Channel, payload []byte) error {
+	var ev eventFields
+	if err := json.Unmarshal(payload, &ev); err != nil {
+		return errors.Wrap(err, "decoding event payload")
+	}
+	if ev.EventType == "" {
+		// Not a structured event.
+		return nil
+	}
+	if err := b.descriptors.AddJSON(len(b.events), payload); err != nil {
+		return err
+	}
+	b.events = append(b.events, storedEvent{ch: ch, payload: append([]byte(nil), payload...)})
+	ts := time.Unix(0, ev.Timestamp).UTC()
+
+	if ch == logpb.Channel_SQL_SCHEMA {
+		b.ddl = append(b.ddl, DDLEvent{
+			Timestamp:    ts,
+			EventType:    ev.EventType,
+			User:         ev.User,
+			DescriptorID: ev.DescriptorID,
+			Statement:    ev.Statement,
+		})
+	}
+
+	if ev.User != "" {
+		u, ok := b.users[ev.User]
+		if !ok {
+			u = &UserActivity{
+				User:        ev.User,
+				FirstSeen:   ts,
+				LastSeen:    ts,
+				EventCounts: make(map[string]int),
+			}
+			b.users[ev.User] = u
+		}
+		u.NumEvents++
+		u.EventCounts[ev.EventType]++
+		u.FirstSeen, u.LastSeen = minTime(u.FirstSeen, ts), maxTime(u.LastSeen, ts)
+	}
+
+	if eventpb.EventType(ev.EventType) == eventpb.EventTypeClientAuthenticationFailed {
+		ip := clientIP(ev.RemoteAddress)
+		a, ok := b.auth[ip]
+		if !ok {
+			a = &AuthFailures{
+				IP:        ip,
+				FirstSeen: ts,
+				LastSeen:  ts,
+				Reasons:   make(map[string]int),
+			}
+			b.auth[ip] = a
+		}
+		a.NumFailures++
+		a.Reasons[ev.Reason.String()]++
+		if ev.User != "" {
+			a.Users = insertSorted(a.Users, ev.User)
+		}
+		a.FirstSeen, a.LastSeen = minTime(a.FirstSeen, ts), maxTime(a.LastSeen, ts)
+	}
+	return nil
+}
+
+// Report returns the report for the events added so far.
+func (b *Builder) Report() *Report {
+	r := &Report{
+		DDLTimeline:  append([]DDLEvent(nil), b.ddl...),
+		UserActivity: make([]UserActivity, 0, len(b.users)),
+		AuthFailures: make([]AuthFailures, 0, len(b.auth)),
+	}
+	sort.SliceStable(r.DDLTimeline, func(i, j int) bool {
+		return r.DDLTimeline[i].Timestamp.Before(r.DDLTimeline[j].Timestamp)
+	})
+	for _, u := range b.users {
+		r.UserActivity = append(r.UserActivity, *u)
+	}
+	sort.Slice(r.UserActivity, func(i, j int) bool {
+		return r.UserActivity[i].User < r.UserActivity[j].User
+	})
+	for _, a := range b.auth {
+		r.AuthFailures = append(r.AuthFailures, *a)
+	}
+	sort.Slice(r.AuthFailures, func(i, j int) bool {
+		return r.AuthFailures[i].IP < r.AuthFailures[j].IP
+	})
+	return r
+}
+
+// clientIP extracts the host part of a client address. Addresses
+// without a port are returned as-is.
+func clientIP(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+func insertSorted(s []string, v string) []string {
+	i := sort.SearchStrings(s, v)
+	if i < len(s) && s[i] == v {
+		return s
+	}
+	s = append(s, "")
+	copy(s[i+1:], s[i:])
+	s[i] = v
+	return s
+}
+
+func minTime(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
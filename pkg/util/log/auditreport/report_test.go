@@ -0,0 +1,101 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package auditreport
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/util/log/logpb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReport(t *testing.T) {
+	b := NewBuilder()
+	for _, ev := range []struct {
+		ch      logpb.Channel
+		payload string
+	}{
+		{logpb.Channel_SQL_SCHEMA, `{"Timestamp":2000,"EventType":"create_table","User":"alice","DescriptorID":57,"Statement":"CREATE TABLE t (x INT)"}`},
+		{logpb.Channel_SQL_SCHEMA, `{"Timestamp":1000,"EventType":"create_database","User":"alice","DescriptorID":56,"Statement":"CREATE DATABASE d"}`},
+		{logpb.Channel_PRIVILEGES, `{"Timestamp":3000,"EventType":"change_table_privilege","User":"bob","DescriptorID":57}`},
+		{logpb.Channel_SESSIONS, `{"Timestamp":4000,"EventType":"client_authentication_failed","User":"mallory","RemoteAddress":"10.0.0.1:5432","Reason":6}`},
+		{logpb.Channel_SESSIONS, `{"Timestamp":5000,"EventType":"client_authentication_failed","User":"eve","RemoteAddress":"10.0.0.1:5433","Reason":6}`},
+		{logpb.Channel_SESSIONS, `{"Timestamp":6000,"EventType":"client_authentication_failed","RemoteAddress":"10.0.0.2:5432","Reason":2}`},
+		// Payloads without an event type are ignored.
+		{logpb.Channel_DEV, `{"Timestamp":7000}`},
+	} {
+		require.NoError(t, b.AddEvent(ev.ch, []byte(ev.payload)))
+	}
+	require.Error(t, b.AddEvent(logpb.Channel_DEV, []byte(`{`)))
+
+	r := b.Report()
+
+	var buf bytes.Buffer
+	require.NoError(t, r.WriteCSV(&buf, SectionDDL))
+	require.Equal(t, `timestamp,event_type,user,descriptor_id,statement
+1970-01-01T00:00:00.000001Z,create_database,alice,56,CREATE DATABASE d
+1970-01-01T00:00:00.000002Z,create_table,alice,57,CREATE TABLE t (x INT)
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, r.WriteCSV(&buf, SectionUsers))
+	require.Equal(t, `user,num_events,first_seen,last_seen,event_counts
+alice,2,1970-01-01T00:00:00.000001Z,1970-01-01T00:00:00.000002Z,create_database=1;create_table=1
+bob,1,1970-01-01T00:00:00.000003Z,1970-01-01T00:00:00.000003Z,change_table_privilege=1
+eve,1,1970-01-01T00:00:00.000005Z,1970-01-01T00:00:00.000005Z,client_authentication_failed=1
+mallory,1,1970-01-01T00:00:00.000004Z,1970-01-01T00:00:00.000004Z,client_authentication_failed=1
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, r.WriteCSV(&buf, SectionAuth))
+	require.Equal(t, `ip,num_failures,first_seen,last_seen,users,reasons
+10.0.0.1,2,1970-01-01T00:00:00.000004Z,1970-01-01T00:00:00.000005Z,eve;mallory,CREDENTIALS_INVALID=2
+10.0.0.2,1,1970-01-01T00:00:00.000006Z,1970-01-01T00:00:00.000006Z,,USER_NOT_FOUND=1
+`, buf.String())
+
+	require.Error(t, r.WriteCSV(&buf, "unknown"))
+
+	buf.Reset()
+	require.NoError(t, r.WriteJSON(&buf))
+	require.Contains(t, buf.String(), `"ddl_timeline"`)
+}
+
+func TestReportForDescriptor(t *testing.T) {
+	b := NewBuilder()
+	for _, ev := range []struct {
+		ch      logpb.Channel
+		payload string
+	}{
+		{logpb.Channel_SQL_SCHEMA, `{"Timestamp":1000,"EventType":"create_table","User":"alice","DescriptorID":57,"Statement":"CREATE TABLE t (x INT)"}`},
+		{logpb.Channel_SQL_SCHEMA, `{"Timestamp":2000,"EventType":"create_table","User":"alice","DescriptorID":58,"Statement":"CREATE TABLE u (x INT)"}`},
+		{logpb.Channel_PRIVILEGES, `{"Timestamp":3000,"EventType":"change_table_privilege","User":"bob","DescriptorID":57}`},
+		{logpb.Channel_OPS, `{"Timestamp":4000,"EventType":"import","User":"carol","DescriptorIDs":[57,58]}`},
+	} {
+		require.NoError(t, b.AddEvent(ev.ch, []byte(ev.payload)))
+	}
+
+	filtered, err := b.ForDescriptor(57)
+	require.NoError(t, err)
+	r := filtered.Report()
+	require.Len(t, r.DDLTimeline, 1)
+	require.Equal(t, "CREATE TABLE t (x INT)", r.DDLTimeline[0].Statement)
+	var users []string
+	for _, u := range r.UserActivity {
+		users = append(users, u.User)
+	}
+	// The import job lists the descriptor among others.
+	require.Equal(t, []string{"alice", "bob", "carol"}, users)
+
+	filtered, err = b.ForDescriptor(59)
+	require.NoError(t, err)
+	require.Empty(t, filtered.Report().UserActivity)
+}
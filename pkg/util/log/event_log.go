@@ -12,10 +12,12 @@ package log
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/cockroachdb/cockroach/pkg/util/log/logpb"
 	"github.com/cockroachdb/cockroach/pkg/util/log/severity"
 	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+	"github.com/cockroachdb/cockroach/pkg/util/tracing"
 )
 
 // StructuredEvent emits a structured event to the debug log.
@@ -28,6 +30,8 @@ func StructuredEvent(ctx context.Context, event logpb.EventPayload) {
 	if len(common.EventType) == 0 {
 		common.EventType = logpb.GetEventTypeName(event)
 	}
+	AnnotateEventWithTrace(ctx, event)
+	AnnotateEventWithTenant(ctx, event)
 
 	entry := makeStructuredEntry(ctx,
 		severity.INFO,
@@ -47,3 +51,39 @@ func StructuredEvent(ctx context.Context, event logpb.EventPayload) {
 	logger := logging.getLogger(entry.ch)
 	logger.outputLogEntry(entry)
 }
+
+// AnnotateEventWithTrace populates the trace and span IDs in the
+// common fields of the event from the tracing span in ctx, if there
+// is one. IDs that are already populated are left untouched.
+//
+// This is called by StructuredEvent, but callers that emit the event
+// later under a different context (e.g. from a txn commit trigger)
+// should call it while the original context is still available.
+func AnnotateEventWithTrace(ctx context.Context, event logpb.EventPayload) {
+	common := event.CommonDetails()
+	if common.TraceID != 0 {
+		return
+	}
+	if sp := tracing.SpanFromContext(ctx); sp != nil {
+		common.TraceID = uint64(sp.TraceID())
+		common.SpanID = uint64(sp.SpanID())
+	}
+}
+
+// AnnotateEventWithTenant populates the tenant ID in the common fields
+// of the event from the server identification in ctx, if it is known
+// and not already populated.
+//
+// Like AnnotateEventWithTrace, this is called by StructuredEvent and
+// should be called by callers that emit the event later.
+func AnnotateEventWithTenant(ctx context.Context, event logpb.EventPayload) {
+	common := event.CommonDetails()
+	if common.TenantID != 0 {
+		return
+	}
+	if tenantID := getIdentificationPayload(ctx).tenantID; tenantID != "" {
+		if id, err := strconv.ParseUint(tenantID, 10, 64); err == nil {
+			common.TenantID = id
+		}
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/util/log/eventpb"
+	"github.com/cockroachdb/cockroach/pkg/util/log/logpb"
+	"github.com/cockroachdb/cockroach/pkg/util/tracing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnnotateEventWithTrace(t *testing.T) {
+	defer ScopeWithoutShowLogs(t).Close(t)
+
+	tracer := tracing.NewTracer()
+	sp := tracer.StartSpan("s", tracing.WithForceRealSpan())
+	defer sp.Finish()
+	ctx := tracing.ContextWithSpan(context.Background(), sp)
+	traceID, spanID := uint64(sp.TraceID()), uint64(sp.SpanID())
+	require.NotZero(t, traceID)
+	require.NotZero(t, spanID)
+
+	t.Run("no span", func(t *testing.T) {
+		ev := &eventpb.CreateDatabase{}
+		AnnotateEventWithTrace(context.Background(), ev)
+		require.Zero(t, ev.TraceID)
+		require.Zero(t, ev.SpanID)
+	})
+
+	t.Run("span", func(t *testing.T) {
+		ev := &eventpb.CreateDatabase{}
+		AnnotateEventWithTrace(ctx, ev)
+		require.Equal(t, traceID, ev.TraceID)
+		require.Equal(t, spanID, ev.SpanID)
+	})
+
+	t.Run("preset", func(t *testing.T) {
+		// IDs captured under an earlier context are preserved.
+		ev := &eventpb.CreateDatabase{CommonEventDetails: logpb.CommonEventDetails{TraceID: 1, SpanID: 2}}
+		AnnotateEventWithTrace(ctx, ev)
+		require.Equal(t, uint64(1), ev.TraceID)
+		require.Equal(t, uint64(2), ev.SpanID)
+	})
+
+	t.Run("structured event", func(t *testing.T) {
+		ev := &eventpb.CreateDatabase{DatabaseName: "foo"}
+		StructuredEvent(ctx, ev)
+		require.Equal(t, traceID, ev.TraceID)
+		require.Equal(t, spanID, ev.SpanID)
+
+		ev = &eventpb.CreateDatabase{CommonEventDetails: logpb.CommonEventDetails{TraceID: 1, SpanID: 2}}
+		StructuredEvent(ctx, ev)
+		require.Equal(t, uint64(1), ev.TraceID)
+		require.Equal(t, uint64(2), ev.SpanID)
+	})
+}
+
+// testTenantIdentity is a ServerIdentificationPayload that only knows
+// the tenant ID.
+type testTenantIdentity string
+
+func (id testTenantIdentity) ServerIdentityString(key ServerIdentificationKey) string {
+	if key == IdentifyTenantID {
+		return string(id)
+	}
+	return ""
+}
+
+func TestAnnotateEventWithTenant(t *testing.T) {
+	defer ScopeWithoutShowLogs(t).Close(t)
+
+	withTenant := func(id string) context.Context {
+		return context.WithValue(context.Background(), ServerIdentificationContextKey{}, testTenantIdentity(id))
+	}
+
+	for _, tc := range []struct {
+		name     string
+		ctx      context.Context
+		preset   uint64
+		expected uint64
+	}{
+		{name: "no identification", ctx: context.Background(), expected: 0},
+		{name: "tenant", ctx: withTenant("10"), expected: 10},
+		{name: "unknown tenant", ctx: withTenant(""), expected: 0},
+		{name: "invalid tenant", ctx: withTenant("foo"), expected: 0},
+		{name: "preset", ctx: withTenant("10"), preset: 5, expected: 5},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ev := &eventpb.CreateDatabase{CommonEventDetails: logpb.CommonEventDetails{TenantID: tc.preset}}
+			AnnotateEventWithTenant(tc.ctx, ev)
+			require.Equal(t, tc.expected, ev.TenantID)
+
+			ev = &eventpb.CreateDatabase{CommonEventDetails: logpb.CommonEventDetails{TenantID: tc.preset}}
+			StructuredEvent(tc.ctx, ev)
+			require.Equal(t, tc.expected, ev.TenantID)
+		})
+	}
+}
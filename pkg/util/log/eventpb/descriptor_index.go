@@ -0,0 +1,135 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package eventpb
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/cockroachdb/cockroach/pkg/util/log/logpb"
+	"github.com/cockroachdb/errors"
+)
+
+// GetDescriptorIDs returns the IDs of the descriptors affected by the
+// given event, as reported in its common payloads. The result is
+// empty for events that do not affect descriptors.
+func GetDescriptorIDs(event logpb.EventPayload) []uint32 {
+	var ids []uint32
+	if ev, ok := event.(EventWithCommonSQLPayload); ok {
+		if id := ev.CommonSQLDetails().DescriptorID; id != 0 {
+			ids = append(ids, id)
+		}
+	}
+	if ev, ok := event.(EventWithCommonSchemaChangePayload); ok {
+		if id := ev.CommonSchemaChangeDetails().DescriptorID; id != 0 {
+			ids = append(ids, id)
+		}
+	}
+	if ev, ok := event.(EventWithCommonJobPayload); ok {
+		for _, id := range ev.CommonJobDetails().DescriptorIDs {
+			if id != 0 {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// storedDescriptorIDs is the subset of the JSON representation of an
+// event that carries descriptor IDs. The field names are those of the
+// common payloads.
+type storedDescriptorIDs struct {
+	DescriptorID  uint32   `json:",omitempty"`
+	DescriptorIDs []uint32 `json:",omitempty"`
+}
+
+// ExtractDescriptorIDs returns the IDs of the descriptors affected by
+// an event, given its JSON representation as stored in the "info"
+// column of system.eventlog or in the payload of a structured log
+// entry.
+func ExtractDescriptorIDs(info []byte) ([]uint32, error) {
+	var s storedDescriptorIDs
+	if err := json.Unmarshal(info, &s); err != nil {
+		return nil, errors.Wrap(err, "decoding event payload")
+	}
+	var ids []uint32
+	if s.DescriptorID != 0 {
+		ids = append(ids, s.DescriptorID)
+	}
+	for _, id := range s.DescriptorIDs {
+		if id != 0 {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// DescriptorIndex indexes a sequence of events by the IDs of the
+// descriptors they affect. Events are identified by their position in
+// the sequence, as provided by the caller when adding them.
+//
+// This is used to answer questions of the form "which events affected
+// table 57" without re-scanning all the stored events.
+type DescriptorIndex struct {
+	byID map[uint32][]int
+}
+
+// MakeDescriptorIndex creates an empty DescriptorIndex.
+func MakeDescriptorIndex() DescriptorIndex {
+	return DescriptorIndex{byID: make(map[uint32][]int)}
+}
+
+// Add indexes the event at position pos, given the event payload.
+func (idx *DescriptorIndex) Add(pos int, event logpb.EventPayload) {
+	idx.addIDs(pos, GetDescriptorIDs(event))
+}
+
+// AddJSON indexes the event at position pos, given its stored JSON
+// representation. See ExtractDescriptorIDs.
+func (idx *DescriptorIndex) AddJSON(pos int, info []byte) error {
+	ids, err := ExtractDescriptorIDs(info)
+	if err != nil {
+		return errors.Wrapf(err, "event at position %d", pos)
+	}
+	idx.addIDs(pos, ids)
+	return nil
+}
+
+func (idx *DescriptorIndex) addIDs(pos int, ids []uint32) {
+outer:
+	for i, id := range ids {
+		// Avoid duplicate positions when an event reports the same
+		// descriptor in multiple common payloads.
+		for _, prev := range ids[:i] {
+			if prev == id {
+				continue outer
+			}
+		}
+		idx.byID[id] = append(idx.byID[id], pos)
+	}
+}
+
+// Lookup returns the positions of the events affecting the descriptor
+// with the given ID, in the order in which they were added.
+func (idx *DescriptorIndex) Lookup(id uint32) []int {
+	return idx.byID[id]
+}
+
+// DescriptorIDs returns the IDs of all the descriptors present in the
+// index, in increasing order.
+func (idx *DescriptorIndex) DescriptorIDs() []uint32 {
+	ids := make([]uint32, 0, len(idx.byID))
+	for id := range idx.byID {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
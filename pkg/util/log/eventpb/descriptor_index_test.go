@@ -0,0 +1,56 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package eventpb
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/util/log/logpb"
+	"github.com/cockroachdb/redact"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescriptorIndex(t *testing.T) {
+	events := []logpb.EventPayload{
+		&CreateTable{CommonSQLEventDetails: CommonSQLEventDetails{DescriptorID: 57}},
+		&CreateDatabase{},
+		&FinishSchemaChange{CommonSchemaChangeEventDetails: CommonSchemaChangeEventDetails{DescriptorID: 57}},
+		&Import{CommonJobEventDetails: CommonJobEventDetails{DescriptorIDs: []uint32{57, 58}}},
+		&DropTable{CommonSQLEventDetails: CommonSQLEventDetails{DescriptorID: 58}},
+	}
+
+	// Index the events directly.
+	idx := MakeDescriptorIndex()
+	for i, ev := range events {
+		idx.Add(i, ev)
+	}
+	assert.Equal(t, []uint32{57, 58}, idx.DescriptorIDs())
+	assert.Equal(t, []int{0, 2, 3}, idx.Lookup(57))
+	assert.Equal(t, []int{3, 4}, idx.Lookup(58))
+	assert.Empty(t, idx.Lookup(59))
+
+	// Index the events from their JSON representation.
+	jsonIdx := MakeDescriptorIndex()
+	for i, ev := range events {
+		var b redact.RedactableBytes
+		b = append(b, '{')
+		_, b = ev.AppendJSONFields(false, b)
+		b = append(b, '}')
+		require.NoError(t, jsonIdx.AddJSON(i, b))
+	}
+	assert.Equal(t, idx, jsonIdx)
+
+	// Invalid payloads are reported with their position.
+	badIdx := MakeDescriptorIndex()
+	assert.EqualError(t, badIdx.AddJSON(3, []byte(`{"DescriptorID":`)),
+		"event at position 3: decoding event payload: unexpected end of JSON input")
+}
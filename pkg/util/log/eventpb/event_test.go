@@ -49,10 +49,16 @@ func TestEventJSON(t *testing.T) {
 
 		// Check that redactable strings get their redaction markers preserved.
 		{&CreateDatabase{CommonSQLEventDetails: CommonSQLEventDetails{Statement: "CREATE DATABASE ‹foo›"}}, `"Statement":"CREATE DATABASE ‹foo›"`},
+		{&CreateDatabase{CommonSQLEventDetails: CommonSQLEventDetails{Statement: "CREATE DATABASE ‹f›", StatementTruncated: true, OriginalStatementLength: 23}},
+			`"Statement":"CREATE DATABASE ‹f›","StatementTruncated":true,"OriginalStatementLength":23`},
 
 		// Integer and boolean fields are not redactable in any case.
 		{&UnsafeDeleteDescriptor{ParentID: 123, Force: true}, `"ParentID":123,"Force":true`},
 
+		// Trace correlation fields are not redactable.
+		{&CreateDatabase{CommonEventDetails: logpb.CommonEventDetails{TraceID: 123, SpanID: 456}}, `"TraceID":123,"SpanID":456`},
+		{&CreateDatabase{CommonEventDetails: logpb.CommonEventDetails{TenantID: 10}}, `"TenantID":10`},
+
 		// Primitive fields without an `includeempty` annotation will NOT emit their
 		// zero value. In this case, `SnapshotID` and `NumRecords` do not have the
 		// `includeempty` annotation, so nothing is emitted, despite the presence of
@@ -66,3 +72,54 @@ func TestEventJSON(t *testing.T) {
 		assert.Equal(t, tc.exp, string(b))
 	}
 }
+
+func TestEventTypes(t *testing.T) {
+	// The generated constants must agree with the names derived from
+	// the payload types at run time.
+	assert.Equal(t, EventTypeCreateDatabase, GetEventType(&CreateDatabase{}))
+	assert.Equal(t, EventTypeSetClusterSetting, GetEventType(&SetClusterSetting{}))
+	assert.Equal(t, EventTypeDebugSendKvBatch, GetEventType(&DebugSendKvBatch{}))
+
+	for _, typ := range EventTypes() {
+		assert.True(t, typ.IsValid(), "%s", typ)
+		_, err := ValidateEventType(string(typ))
+		assert.NoError(t, err)
+	}
+
+	_, err := ValidateEventType("create_databse")
+	assert.EqualError(t, err, `unknown event type: "create_databse"`)
+	assert.False(t, EventType("").IsValid())
+}
+
+func TestTruncateStatement(t *testing.T) {
+	testCases := []struct {
+		stmt   redact.RedactableString
+		maxLen int
+		exp    redact.RedactableString
+	}{
+		// No truncation when disabled or when the statement is short enough.
+		{"SELECT 1", 0, "SELECT 1"},
+		{"SELECT 1", 8, "SELECT 1"},
+		// Plain truncation.
+		{"SELECT 123", 8, "SELECT 1"},
+		// Truncation inside a sensitive region closes the region.
+		{"SELECT ‹secret›", 11, "SELECT ‹s›"},
+		// Truncation after a sensitive region does not add markers.
+		{"SELECT ‹a› FROM t", 15, "SELECT ‹a› "},
+		// Truncation does not split multi-byte characters.
+		{"SELECT 'é'", 9, "SELECT '"},
+	}
+
+	for _, tc := range testCases {
+		d := CommonSQLEventDetails{Statement: tc.stmt}
+		d.TruncateStatement(tc.maxLen)
+		assert.Equal(t, tc.exp, d.Statement)
+		truncated := tc.exp != tc.stmt
+		assert.Equal(t, truncated, d.StatementTruncated)
+		if truncated {
+			assert.Equal(t, uint32(len(tc.stmt)), d.OriginalStatementLength)
+		} else {
+			assert.Zero(t, d.OriginalStatementLength)
+		}
+	}
+}
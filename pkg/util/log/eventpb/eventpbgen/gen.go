@@ -689,6 +689,41 @@ import "github.com/cockroachdb/cockroach/pkg/util/log/logpb"
 // LoggingChannel implements the EventPayload interface.
 func (m *{{.GoType}}) LoggingChannel() logpb.Channel { return logpb.Channel_{{.LogChannel}} }
 {{end}}
+`,
+
+	"event_types_go": `// Code generated by gen.go. DO NOT EDIT.
+
+package {{ .Package }}
+
+// EventType identifies the type of a structured event. Its value is
+// the snake_case form of the name of the event payload type, as stored
+// in the EventType field of the common event details and in the
+// "eventType" column of system.eventlog.
+type EventType string
+
+{{range .Categories}}
+// Event types in the "{{.Title}}" category.
+const (
+{{- range .Events}}
+  // EventType{{.GoType}} is the type of {{.GoType}} events.
+  EventType{{.GoType}} EventType = "{{.Type}}"
+{{- end}}
+)
+{{end}}
+
+// eventTypes lists all the known event types, in the order of
+// their category.
+var eventTypes = [...]EventType{
+{{- range .Events}}
+  EventType{{.GoType}},
+{{- end}}
+}
+
+var eventTypeSet = map[EventType]struct{}{
+{{- range .Events}}
+  EventType{{.GoType}}: {},
+{{- end}}
+}
 `,
 
 	"eventlog.md": `Certain notable events are reported using a structured format.
@@ -10,7 +10,14 @@
 
 package eventpb
 
-import "github.com/cockroachdb/cockroach/pkg/util/log/logpb"
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/cockroach/pkg/util/log/logpb"
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/redact"
+)
 
 // EventWithCommonSQLPayload is implemented by CommonSQLEventDetails.
 type EventWithCommonSQLPayload interface {
@@ -21,6 +28,46 @@ type EventWithCommonSQLPayload interface {
 // CommonSQLDetails implements the EventWithCommonSQLPayload interface.
 func (m *CommonSQLEventDetails) CommonSQLDetails() *CommonSQLEventDetails { return m }
 
+// TruncateStatement truncates the Statement field to at most maxLen
+// bytes, and records the truncation in the StatementTruncated and
+// OriginalStatementLength fields. A maxLen of zero or less disables
+// truncation.
+//
+// The truncation preserves the validity of the redactable string: the
+// statement is cut on a rune boundary, and if the cut happens inside a
+// sensitive region, the region is closed with a redaction marker. The
+// resulting string can therefore exceed maxLen by the size of one
+// marker.
+func (m *CommonSQLEventDetails) TruncateStatement(maxLen int) {
+	stmt := string(m.Statement)
+	if maxLen <= 0 || len(stmt) <= maxLen {
+		return
+	}
+	startMarker, endMarker := string(redact.StartMarker()), string(redact.EndMarker())
+	cut := 0
+	inRedactedRegion := false
+	for cut < maxLen {
+		_, size := utf8.DecodeRuneInString(stmt[cut:])
+		if cut+size > maxLen {
+			break
+		}
+		switch {
+		case strings.HasPrefix(stmt[cut:], startMarker):
+			inRedactedRegion = true
+		case strings.HasPrefix(stmt[cut:], endMarker):
+			inRedactedRegion = false
+		}
+		cut += size
+	}
+	truncated := stmt[:cut]
+	if inRedactedRegion {
+		truncated += endMarker
+	}
+	m.OriginalStatementLength = uint32(len(stmt))
+	m.StatementTruncated = true
+	m.Statement = redact.RedactableString(truncated)
+}
+
 // EventWithCommonSchemaChangePayload is implemented by CommonSchemaChangeDetails.
 type EventWithCommonSchemaChangePayload interface {
 	logpb.EventPayload
@@ -50,3 +97,30 @@ var _ EventWithCommonJobPayload = (*Restore)(nil)
 
 // RecoveryEventType describes the type of recovery for a RecoveryEvent.
 type RecoveryEventType string
+
+// IsValid returns true iff t is one of the event types defined in
+// this package.
+func (t EventType) IsValid() bool {
+	_, ok := eventTypeSet[t]
+	return ok
+}
+
+// EventTypes returns all the known event types.
+func EventTypes() []EventType {
+	return append([]EventType(nil), eventTypes[:]...)
+}
+
+// ValidateEventType checks that the given string is a known event
+// type and returns it as an EventType.
+func ValidateEventType(s string) (EventType, error) {
+	t := EventType(s)
+	if !t.IsValid() {
+		return "", errors.Newf("unknown event type: %q", s)
+	}
+	return t, nil
+}
+
+// GetEventType returns the EventType for the given event payload.
+func GetEventType(event logpb.EventPayload) EventType {
+	return EventType(logpb.GetEventTypeName(event))
+}